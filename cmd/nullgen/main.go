@@ -0,0 +1,302 @@
+// Command nullgen wraps a user-defined type in a nullable type following the
+// conventions of package types: a struct of the value and a validity flag,
+// with Scan, Value, JSON and text implementations.
+//
+// It is meant to be run from go:generate in the package declaring the type:
+//
+//	//go:generate go run github.com/j0h-dev/simple-types-go/cmd/nullgen -type=Email -kind=text
+//
+// This writes nullemail.go declaring NullEmail. The -kind flag tells nullgen
+// how the type is stored:
+//
+//   - text: the type implements encoding.TextMarshaler and
+//     encoding.TextUnmarshaler on its pointer, and is stored as a string.
+//     As in package types, an empty string decodes as NULL.
+//   - string, int64, float64 or bool: the type's underlying kind, which is
+//     stored directly. The text form is that of package strconv, and empty
+//     text decodes as NULL.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("nullgen: ")
+
+	typeName := flag.String("type", "", "name of the type to wrap (required)")
+	kind := flag.String("kind", "text", "storage kind: text, string, int64, float64 or bool")
+	name := flag.String("name", "", "name of the generated type (default Null<type>)")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	output := flag.String("output", "", "output file (default <name>.go, lowercased)")
+	flag.Parse()
+
+	if *typeName == "" || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *name == "" {
+		*name = "Null" + *typeName
+	}
+	if *output == "" {
+		*output = strings.ToLower(*name) + ".go"
+	}
+
+	src, err := generate(*pkg, *typeName, *name, *kind)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source of the nullable wrapper.
+func generate(pkg, typeName, name, kind string) ([]byte, error) {
+	if !token.IsIdentifier(typeName) {
+		return nil, fmt.Errorf("type %q is not a Go identifier", typeName)
+	}
+	if !token.IsIdentifier(name) {
+		return nil, fmt.Errorf("name %q is not a Go identifier", name)
+	}
+	switch kind {
+	case "text", "string", "int64", "float64", "bool":
+	default:
+		return nil, fmt.Errorf("unknown kind %q", kind)
+	}
+
+	var buf bytes.Buffer
+	err := wrapperTemplate.Execute(&buf, map[string]any{
+		"Package": pkg,
+		"Type":    typeName,
+		"Name":    name,
+		"Kind":    kind,
+		"Text":    kind == "text",
+		"Args":    strings.Join(os.Args[1:], " "),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+var wrapperTemplate = template.Must(template.New("wrapper").Parse(`// Code generated by nullgen {{.Args}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if not .Text}}
+	"database/sql"
+{{- end}}
+	"database/sql/driver"
+	"encoding/json"
+{{- if .Text}}
+	"fmt"
+{{- else if ne .Kind "string"}}
+	"strconv"
+{{- end}}
+)
+
+// {{.Name}} is a nullable {{.Type}}. The zero value is invalid (NULL).
+type {{.Name}} struct {
+	Val   {{.Type}}
+	Valid bool
+}
+
+// New{{.Name}} creates a new valid {{.Name}}.
+func New{{.Name}}(v {{.Type}}) {{.Name}} {
+	return {{.Name}}{Val: v, Valid: true}
+}
+{{if .Text}}
+// Scan implements the sql.Scanner interface.
+// It converts database values into a {{.Name}}, supporting NULL, string, and []byte.
+func (n *{{.Name}}) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*n = {{.Name}}{}
+		return nil
+	case string:
+		return n.UnmarshalText([]byte(v))
+	case []byte:
+		return n.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into {{.Name}}", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the text form of the value as a string, or nil if invalid.
+func (n {{.Name}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	text, err := n.Val.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the text form of the value as a JSON string, or null if invalid.
+func (n {{.Name}}) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	text, err := n.Val.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON string into the {{.Name}}, handling null and empty strings.
+func (n *{{.Name}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = {{.Name}}{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return n.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid {{.Name}} is encoded as empty text.
+func (n {{.Name}}) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Val.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the {{.Name}} invalid.
+func (n *{{.Name}}) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = {{.Name}}{}
+		return nil
+	}
+	var v {{.Type}}
+	if err := v.UnmarshalText(text); err != nil {
+		return err
+	}
+	*n = New{{.Name}}(v)
+	return nil
+}
+
+// String returns the text form of the value, or an empty string if invalid.
+func (n {{.Name}}) String() string {
+	text, _ := n.MarshalText()
+	return string(text)
+}
+{{else}}
+// Scan implements the sql.Scanner interface.
+// It converts database values into a {{.Name}} the same way as sql.Null[{{.Kind}}].
+func (n *{{.Name}}) Scan(value any) error {
+	var v sql.Null[{{.Kind}}]
+	if err := v.Scan(value); err != nil {
+		return err
+	}
+	*n = {{.Name}}{Val: {{.Type}}(v.V), Valid: v.Valid}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the value as a {{.Kind}}, or nil if invalid.
+func (n {{.Name}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return {{.Kind}}(n.Val), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the value as JSON, or null if invalid.
+func (n {{.Name}}) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes JSON into the {{.Name}}, handling null.
+func (n *{{.Name}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = {{.Name}}{}
+		return nil
+	}
+	var v {{.Type}}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = New{{.Name}}(v)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid {{.Name}} is encoded as empty text.
+func (n {{.Name}}) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+{{- if eq .Kind "string"}}
+	return []byte(n.Val), nil
+{{- else if eq .Kind "int64"}}
+	return strconv.AppendInt(nil, int64(n.Val), 10), nil
+{{- else if eq .Kind "float64"}}
+	return strconv.AppendFloat(nil, float64(n.Val), 'g', -1, 64), nil
+{{- else}}
+	return strconv.AppendBool(nil, bool(n.Val)), nil
+{{- end}}
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the {{.Name}} invalid.
+func (n *{{.Name}}) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*n = {{.Name}}{}
+		return nil
+	}
+{{- if eq .Kind "string"}}
+	*n = New{{.Name}}({{.Type}}(text))
+{{- else}}
+{{- if eq .Kind "int64"}}
+	v, err := strconv.ParseInt(string(text), 10, 64)
+{{- else if eq .Kind "float64"}}
+	v, err := strconv.ParseFloat(string(text), 64)
+{{- else}}
+	v, err := strconv.ParseBool(string(text))
+{{- end}}
+	if err != nil {
+		return err
+	}
+	*n = New{{.Name}}({{.Type}}(v))
+{{- end}}
+	return nil
+}
+
+// String returns the text form of the value, or an empty string if invalid.
+func (n {{.Name}}) String() string {
+	text, _ := n.MarshalText()
+	return string(text)
+}
+{{end}}
+// IsZero reports whether the {{.Name}} is invalid.
+func (n {{.Name}}) IsZero() bool {
+	return !n.Valid
+}
+`))
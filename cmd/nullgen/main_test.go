@@ -0,0 +1,64 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name, typeName, wrapper, kind string
+	}{
+		{"invalid type", "my-type", "NullMyType", "text"},
+		{"empty type", "", "Null", "text"},
+		{"invalid name", "Email", "Null Email", "text"},
+		{"unknown kind", "Email", "NullEmail", "int32"},
+		{"empty kind", "Email", "NullEmail", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := generate("p", tt.typeName, tt.wrapper, tt.kind); err == nil {
+				t.Errorf("generate(%q, %q, %q) succeeded, want error", tt.typeName, tt.wrapper, tt.kind)
+			}
+		})
+	}
+}
+
+// TestGeneratedCode compiles the wrappers generated for every kind, together
+// with the types in testdata/types.go, and runs testdata/wrappers_test.go
+// against them.
+func TestGeneratedCode(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip(err)
+	}
+	files := map[string][]byte{"go.mod": []byte("module example.com/p\n\ngo 1.24\n")}
+	for _, name := range []string{"types.go", "wrappers_test.go"} {
+		if files[name], err = os.ReadFile(filepath.Join("testdata", name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for typeName, kind := range map[string]string{"Email": "text", "Code": "string", "Cents": "int64", "Ratio": "float64", "Flag": "bool"} {
+		src, err := generate("p", typeName, "Null"+typeName, kind)
+		if err != nil {
+			t.Fatalf("generate(%s, %s): %v", typeName, kind, err)
+		}
+		files[strings.ToLower("Null"+typeName)+".go"] = src
+	}
+
+	dir := t.TempDir()
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(goTool, "test", "-vet=all", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test of the generated code: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,25 @@
+// Types wrapped by the generated code in TestGeneratedCode.
+package p
+
+import (
+	"errors"
+	"strings"
+)
+
+type Email struct{ User, Host string }
+
+func (e Email) MarshalText() ([]byte, error) { return []byte(e.User + "@" + e.Host), nil }
+
+func (e *Email) UnmarshalText(text []byte) error {
+	user, host, ok := strings.Cut(string(text), "@")
+	if !ok {
+		return errors.New("missing @")
+	}
+	*e = Email{user, host}
+	return nil
+}
+
+type Code string
+type Cents int64
+type Ratio float64
+type Flag bool
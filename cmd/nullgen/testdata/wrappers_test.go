@@ -0,0 +1,95 @@
+// Tests of the generated wrappers, run by TestGeneratedCode.
+package p
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type wrapper interface {
+	json.Marshaler
+	MarshalText() ([]byte, error)
+	String() string
+	IsZero() bool
+}
+
+func check[T any, P interface {
+	*T
+	wrapper
+	json.Unmarshaler
+	UnmarshalText([]byte) error
+	Scan(any) error
+}](t *testing.T, valid T, text string, jsonText string, scanned any, bad []string) {
+	t.Helper()
+	var zero T
+	if !P(&zero).IsZero() || P(&valid).IsZero() {
+		t.Errorf("%T: IsZero wrong", valid)
+	}
+	if out, _ := P(&zero).MarshalJSON(); string(out) != "null" {
+		t.Errorf("%T: MarshalJSON of NULL = %s", valid, out)
+	}
+	if out, _ := P(&valid).MarshalJSON(); string(out) != jsonText {
+		t.Errorf("%T: MarshalJSON = %s, want %s", valid, out, jsonText)
+	}
+	if s := P(&valid).String(); s != text {
+		t.Errorf("%T: String = %q, want %q", valid, s, text)
+	}
+	if s := P(&zero).String(); s != "" {
+		t.Errorf("%T: String of NULL = %q", valid, s)
+	}
+
+	var got T
+	if err := P(&got).UnmarshalJSON([]byte(jsonText)); err != nil || !reflect.DeepEqual(got, valid) {
+		t.Errorf("%T: UnmarshalJSON(%s) = %v, %v", valid, jsonText, got, err)
+	}
+	if err := P(&got).UnmarshalJSON([]byte("null")); err != nil || !reflect.DeepEqual(got, zero) {
+		t.Errorf("%T: UnmarshalJSON(null) = %v, %v", valid, got, err)
+	}
+	if err := P(&got).UnmarshalText([]byte(text)); err != nil || !reflect.DeepEqual(got, valid) {
+		t.Errorf("%T: UnmarshalText(%q) = %v, %v", valid, text, got, err)
+	}
+	if err := P(&got).UnmarshalText(nil); err != nil || !reflect.DeepEqual(got, zero) {
+		t.Errorf("%T: UnmarshalText(empty) = %v, %v", valid, got, err)
+	}
+	if err := P(&got).Scan(scanned); err != nil || !reflect.DeepEqual(got, valid) {
+		t.Errorf("%T: Scan(%#v) = %v, %v", valid, scanned, got, err)
+	}
+	if err := P(&got).Scan(nil); err != nil || !reflect.DeepEqual(got, zero) {
+		t.Errorf("%T: Scan(nil) = %v, %v", valid, got, err)
+	}
+	for _, b := range bad {
+		if err := P(&got).UnmarshalText([]byte(b)); err == nil {
+			t.Errorf("%T: UnmarshalText(%q) succeeded, want error", valid, b)
+		}
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	check(t, NewNullEmail(Email{"a", "example.com"}), "a@example.com", `"a@example.com"`, []byte("a@example.com"), []string{"nobody"})
+	check(t, NewNullCode("X1"), "X1", `"X1"`, "X1", nil)
+	check(t, NewNullCents(-150), "-150", "-150", int64(-150), []string{"1.5", "abc"})
+	check(t, NewNullRatio(0.25), "0.25", "0.25", 0.25, []string{"quarter"})
+	check(t, NewNullFlag(true), "true", "true", true, []string{"yes"})
+
+	if v, err := NewNullCents(7).Value(); err != nil || v != int64(7) {
+		t.Errorf("Value = %#v, %v, want int64(7)", v, err)
+	}
+	if v, err := (NullEmail{}).Value(); err != nil || v != nil {
+		t.Errorf("Value of NULL = %#v, %v, want nil", v, err)
+	}
+	if v, err := NewNullEmail(Email{"a", "b"}).Value(); err != nil || v != "a@b" {
+		t.Errorf("Value = %#v, %v, want a@b", v, err)
+	}
+	var e NullEmail
+	if err := e.Scan(42); err == nil {
+		t.Error("Scan(42) into NullEmail succeeded, want error")
+	}
+	if err := e.UnmarshalJSON([]byte(`""`)); err != nil || e.Valid {
+		t.Errorf("UnmarshalJSON(\"\") = %v, %v, want NULL", e, err)
+	}
+	var c NullCents
+	if err := c.UnmarshalJSON([]byte(`"7"`)); err == nil {
+		t.Error("UnmarshalJSON of a string into NullCents succeeded, want error")
+	}
+}
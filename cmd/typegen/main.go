@@ -0,0 +1,241 @@
+// Command typegen generates a nullable enum type in the style of package types:
+// a struct of the value and a validity flag, with Scan, Value, JSON and text
+// implementations that reject values outside the enum.
+//
+// It is meant to be run from go:generate:
+//
+//	//go:generate go run github.com/j0h-dev/simple-types-go/cmd/typegen -type=Status -values=active,suspended,deleted
+//
+// This writes status_enum.go declaring the Status type, a variable for each
+// value (StatusActive, StatusSuspended, StatusDeleted) and an AllValues method
+// listing them. As elsewhere in package types, an empty string decodes as NULL.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("typegen: ")
+
+	typeName := flag.String("type", "", "name of the enum type to generate (required)")
+	values := flag.String("values", "", "comma-separated enum values (required)")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	output := flag.String("output", "", "output file (default <type>_enum.go)")
+	flag.Parse()
+
+	if *typeName == "" || *values == "" || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *output == "" {
+		*output = strings.ToLower(*typeName) + "_enum.go"
+	}
+
+	src, err := generate(*pkg, *typeName, strings.Split(*values, ","))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// enumValue is a single value of the generated enum.
+type enumValue struct {
+	Ident string // Go identifier of the variable holding the value
+	Value string // value as stored in the database and JSON
+}
+
+// generate returns the formatted source of the enum type.
+func generate(pkg, typeName string, values []string) ([]byte, error) {
+	if !token.IsIdentifier(typeName) || !token.IsExported(typeName) {
+		return nil, fmt.Errorf("type %q is not an exported Go identifier", typeName)
+	}
+
+	seen := make(map[string]bool)
+	enum := make([]enumValue, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return nil, fmt.Errorf("empty enum value, which is reserved for NULL")
+		}
+		suffix := identifier(v)
+		if suffix == "" {
+			return nil, fmt.Errorf("enum value %q has no letters or digits to name its identifier", v)
+		}
+		ident := typeName + suffix
+		if seen[ident] {
+			return nil, fmt.Errorf("enum value %q duplicates the identifier %s", v, ident)
+		}
+		seen[ident] = true
+		enum = append(enum, enumValue{Ident: ident, Value: v})
+	}
+
+	var buf bytes.Buffer
+	err := enumTemplate.Execute(&buf, map[string]any{
+		"Package": pkg,
+		"Type":    typeName,
+		"Values":  enum,
+		"Args":    strings.Join(os.Args[1:], " "),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// identifier converts an enum value such as "in_progress" to the identifier
+// suffix "InProgress".
+func identifier(v string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range v {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var enumTemplate = template.Must(template.New("enum").Parse(`// Code generated by typegen {{.Args}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// {{.Type}} is a nullable enum. The zero value is invalid (NULL).
+type {{.Type}} struct {
+	Val   string
+	Valid bool
+}
+
+// Values of {{.Type}}.
+var (
+{{- range .Values}}
+	{{.Ident}} = {{$.Type}}{Val: {{printf "%q" .Value}}, Valid: true}
+{{- end}}
+)
+
+// AllValues returns every valid value of {{.Type}}, in declaration order.
+func ({{.Type}}) AllValues() []{{.Type}} {
+	return []{{.Type}}{ {{- range $i, $v := .Values}}{{if $i}}, {{end}}{{$v.Ident}}{{end -}} }
+}
+
+// Parse{{.Type}} parses s into a {{.Type}}. An empty string is NULL.
+func Parse{{.Type}}(s string) ({{.Type}}, error) {
+	switch s {
+	case "":
+		return {{.Type}}{}, nil
+{{- range .Values}}
+	case {{printf "%q" .Value}}:
+		return {{.Ident}}, nil
+{{- end}}
+	default:
+		return {{.Type}}{}, fmt.Errorf("invalid {{.Type}} %q", s)
+	}
+}
+
+// Scan implements the sql.Scanner interface.
+// It converts database values into a {{.Type}}, supporting NULL, string, and []byte.
+func (e *{{.Type}}) Scan(value any) error {
+	var err error
+	switch v := value.(type) {
+	case nil:
+		*e = {{.Type}}{}
+	case string:
+		*e, err = Parse{{.Type}}(v)
+	case []byte:
+		*e, err = Parse{{.Type}}(string(v))
+	default:
+		err = fmt.Errorf("cannot scan %T into {{.Type}}", value)
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the value as a string, or nil if invalid.
+func (e {{.Type}}) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return e.Val, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the value as a JSON string, or null if invalid.
+func (e {{.Type}}) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(e.Val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON string into the {{.Type}}, handling null and empty strings.
+func (e *{{.Type}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*e = {{.Type}}{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := Parse{{.Type}}(s)
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid {{.Type}} is encoded as empty text.
+func (e {{.Type}}) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *{{.Type}}) UnmarshalText(text []byte) error {
+	v, err := Parse{{.Type}}(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// IsZero reports whether the {{.Type}} is invalid.
+func (e {{.Type}}) IsZero() bool {
+	return !e.Valid
+}
+
+// String returns the value, or an empty string if invalid.
+func (e {{.Type}}) String() string {
+	if !e.Valid {
+		return ""
+	}
+	return e.Val
+}
+`))
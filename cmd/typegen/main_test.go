@@ -0,0 +1,84 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name, typeName string
+		values         []string
+	}{
+		{"unexported type", "status", []string{"active"}},
+		{"invalid type", "My-Status", []string{"active"}},
+		{"empty value", "Status", []string{"active", " "}},
+		{"no letters or digits", "Status", []string{"active", "--"}},
+		{"duplicate identifier", "Status", []string{"in_progress", "in-progress"}},
+		{"duplicate value", "Status", []string{"active", "active"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := generate("p", tt.typeName, tt.values); err == nil {
+				t.Errorf("generate(%q, %q) succeeded, want error", tt.typeName, tt.values)
+			}
+		})
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"active", "Active"},
+		{"in_progress", "InProgress"},
+		{"in-progress", "InProgress"},
+		{"on hold", "OnHold"},
+		{"HTTP2", "HTTP2"},
+		{"2fa", "2fa"},
+		{"über", "Über"},
+		{"--", ""},
+	}
+	for _, tt := range tests {
+		if got := identifier(tt.in); got != tt.want {
+			t.Errorf("identifier(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// TestGeneratedCode compiles an enum generated from values with separators
+// and spaces and runs testdata/enum_test.go against it.
+func TestGeneratedCode(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip(err)
+	}
+	src, err := generate("p", "Status", []string{"active", " in_progress", "on hold", "2fa"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test, err := os.ReadFile(filepath.Join("testdata", "enum_test.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"go.mod":         []byte("module example.com/p\n\ngo 1.24\n"),
+		"status_enum.go": src,
+		"enum_test.go":   test,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(goTool, "test", "-vet=all", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test of the generated code: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,105 @@
+// Tests of the generated enum, run by TestGeneratedCode.
+package p
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	want := []Status{StatusActive, StatusInProgress, StatusOnHold, Status2fa}
+	if got := (Status{}).AllValues(); !slices.Equal(got, want) {
+		t.Errorf("AllValues = %v, want %v", got, want)
+	}
+	if StatusInProgress.Val != "in_progress" || StatusOnHold.Val != "on hold" {
+		t.Errorf("values = %q, %q, want them trimmed and otherwise unchanged", StatusInProgress.Val, StatusOnHold.Val)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Status
+		wantErr bool
+	}{
+		{"active", StatusActive, false},
+		{"on hold", StatusOnHold, false},
+		{"", Status{}, false},
+		{"Active", Status{}, true},
+		{"deleted", Status{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseStatus(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseStatus(%q) = %v, %v, want %v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestScanValue(t *testing.T) {
+	var s Status
+	for _, src := range []any{"in_progress", []byte("in_progress")} {
+		if err := s.Scan(src); err != nil || s != StatusInProgress {
+			t.Errorf("Scan(%#v) = %v, %v", src, s, err)
+		}
+	}
+	if err := s.Scan(nil); err != nil || s.Valid {
+		t.Errorf("Scan(nil) = %v, %v, want NULL", s, err)
+	}
+	if err := s.Scan("deleted"); err == nil {
+		t.Error("Scan(deleted) succeeded, want error")
+	}
+	if err := s.Scan(1); err == nil {
+		t.Error("Scan(1) succeeded, want error")
+	}
+	if v, err := StatusActive.Value(); err != nil || v != "active" {
+		t.Errorf("Value = %#v, %v, want active", v, err)
+	}
+	if v, err := (Status{}).Value(); err != nil || v != nil {
+		t.Errorf("Value of NULL = %#v, %v, want nil", v, err)
+	}
+}
+
+func TestJSONAndText(t *testing.T) {
+	type payload struct {
+		S Status `json:"s"`
+	}
+	out, err := json.Marshal(payload{StatusOnHold})
+	if err != nil || string(out) != `{"s":"on hold"}` {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+	if out, _ := json.Marshal(payload{}); string(out) != `{"s":null}` {
+		t.Errorf("Marshal of NULL = %s", out)
+	}
+
+	tests := []struct {
+		in      string
+		want    Status
+		wantErr bool
+	}{
+		{`{"s":"2fa"}`, Status2fa, false},
+		{`{"s":null}`, Status{}, false},
+		{`{"s":""}`, Status{}, false},
+		{`{"s":"deleted"}`, Status{}, true},
+		{`{"s":1}`, Status{}, true},
+	}
+	for _, tt := range tests {
+		var p payload
+		err := json.Unmarshal([]byte(tt.in), &p)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && p.S != tt.want) {
+			t.Errorf("Unmarshal(%s) = %v, %v, want %v, error %v", tt.in, p.S, err, tt.want, tt.wantErr)
+		}
+	}
+
+	var s Status
+	if err := s.UnmarshalText([]byte("active")); err != nil || s != StatusActive {
+		t.Errorf("UnmarshalText = %v, %v", s, err)
+	}
+	if text, _ := StatusActive.MarshalText(); string(text) != "active" {
+		t.Errorf("MarshalText = %q", text)
+	}
+	if !(Status{}).IsZero() || StatusActive.IsZero() || (Status{}).String() != "" {
+		t.Error("IsZero or String of NULL is wrong")
+	}
+}
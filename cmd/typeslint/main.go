@@ -0,0 +1,13 @@
+// Command typeslint runs the typeslint analyzer as a go vet tool:
+//
+//	go vet -vettool=$(which typeslint) ./...
+package main
+
+import (
+	"github.com/j0h-dev/simple-types-go/typeslint"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(typeslint.Analyzer)
+}
@@ -0,0 +1,54 @@
+// Package fields resolves the exported fields of a struct to the names used by
+// tag-driven encoders such as BigQuery and Firestore.
+package fields
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Field is a struct field together with the name it is encoded under.
+type Field struct {
+	Name      string
+	Index     []int
+	OmitEmpty bool
+}
+
+// Of returns the encoded fields of the struct type t. Each field is named by
+// the given struct tag, falling back to the field name. Fields tagged "-",
+// unexported fields and embedded struct fields themselves are skipped; fields
+// promoted from embedded structs are included.
+func Of(t reflect.Type, tag string) []Field {
+	visible := reflect.VisibleFields(t)
+	fields := make([]Field, 0, len(visible))
+	for _, f := range visible {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, Field{
+			Name:      name,
+			Index:     f.Index,
+			OmitEmpty: hasOption(opts, "omitempty"),
+		})
+	}
+	return fields
+}
+
+// hasOption reports whether the comma-separated tag options contain opt.
+func hasOption(opts, opt string) bool {
+	for opts != "" {
+		var o string
+		o, opts, _ = strings.Cut(opts, ",")
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
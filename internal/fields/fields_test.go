@@ -0,0 +1,80 @@
+package fields
+
+import (
+	"reflect"
+	"testing"
+)
+
+type inner struct {
+	City string `json:"city"`
+	Zip  string
+}
+
+type Embedded struct {
+	Country string `json:"country,omitempty"`
+}
+
+type record struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Quoted   int    `json:"quoted,string,omitempty"`
+	NoName   string `json:",omitempty"`
+	Plain    string
+	Skipped  string `json:"-"`
+	unexport string
+	Other    string `bigquery:"other_name"`
+	Address  inner  `json:"address"`
+	Embedded
+	*inner
+}
+
+func TestOf(t *testing.T) {
+	want := []Field{
+		{Name: "id", Index: []int{0}},
+		{Name: "name", Index: []int{1}, OmitEmpty: true},
+		{Name: "quoted", Index: []int{2}, OmitEmpty: true},
+		{Name: "NoName", Index: []int{3}, OmitEmpty: true},
+		{Name: "Plain", Index: []int{4}},
+		{Name: "Other", Index: []int{7}},
+		{Name: "address", Index: []int{8}},
+		{Name: "country", Index: []int{9, 0}, OmitEmpty: true},
+		{Name: "city", Index: []int{10, 0}},
+		{Name: "Zip", Index: []int{10, 1}},
+	}
+	got := Of(reflect.TypeFor[record](), "json")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Of(record, json) =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestOfOtherTag(t *testing.T) {
+	got := Of(reflect.TypeFor[record](), "bigquery")
+	for _, f := range got {
+		if f.Index[0] == 7 {
+			if f.Name != "other_name" {
+				t.Errorf("field Other is named %q, want other_name", f.Name)
+			}
+			return
+		}
+	}
+	t.Error("field Other is missing")
+}
+
+func TestHasOption(t *testing.T) {
+	tests := []struct {
+		opts string
+		want bool
+	}{
+		{"", false},
+		{"omitempty", true},
+		{"string,omitempty", true},
+		{"omitempty,string", true},
+		{"omitemptyx", false},
+		{"string", false},
+	}
+	for _, tt := range tests {
+		if got := hasOption(tt.opts, "omitempty"); got != tt.want {
+			t.Errorf("hasOption(%q, omitempty) = %v, want %v", tt.opts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+// Sinks keep the compiler from optimizing away the results being measured.
+var (
+	valueSink driver.Value
+	bytesSink []byte
+)
+
+// assertAllocs fails t if f allocates more than max times per run on average.
+func assertAllocs(t *testing.T, name string, max float64, f func()) {
+	t.Helper()
+	if got := testing.AllocsPerRun(100, f); got > max {
+		t.Errorf("%s: got %v allocs per run, want at most %v", name, got, max)
+	}
+}
+
+// benchScan runs Scan of src into v b.N times.
+func benchScan(b *testing.B, v interface{ Scan(any) error }, src any) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := v.Scan(src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchValue runs Value of v b.N times.
+func benchValue(b *testing.B, v driver.Valuer) {
+	b.ReportAllocs()
+	for b.Loop() {
+		valueSink, _ = v.Value()
+	}
+}
+
+// benchAppendJSON runs AppendJSON of v b.N times, reusing one buffer.
+func benchAppendJSON(b *testing.B, v interface{ AppendJSON([]byte) []byte }) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for b.Loop() {
+		buf = v.AppendJSON(buf[:0])
+	}
+	bytesSink = buf
+}
+
+// benchMarshalJSON runs MarshalJSON of v b.N times.
+func benchMarshalJSON(b *testing.B, v interface{ MarshalJSON() ([]byte, error) }) {
+	b.ReportAllocs()
+	for b.Loop() {
+		bytesSink, _ = v.MarshalJSON()
+	}
+}
+
+// benchUnmarshalJSON runs UnmarshalJSON of data into v b.N times.
+func benchUnmarshalJSON(b *testing.B, v interface{ UnmarshalJSON([]byte) error }, data []byte) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := v.UnmarshalJSON(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
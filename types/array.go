@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errNullElement is wrapped when an array holds a NULL element its Go type cannot represent.
+var errNullElement = errors.New("NULL element")
+
+// scanArrayLiteral calls fn for each element of a one-dimensional PostgreSQL
+// array literal, such as {1,"a b",NULL}, in order. Quoted elements are
+// unquoted, and null reports an unquoted NULL. Errors returned by fn are
+// wrapped with the index of the element.
+func scanArrayLiteral(text string, fn func(i int, elem string, null bool) error) error {
+	body := text
+	// Arrays with non-default bounds are prefixed with their dimensions, e.g. "[0:1]={...}".
+	if _, rest, ok := strings.Cut(body, "="); ok && strings.HasPrefix(body, "[") {
+		body = rest
+	}
+	if len(body) < 2 || body[0] != '{' || body[len(body)-1] != '}' {
+		return errors.New("missing braces")
+	}
+	body = body[1 : len(body)-1]
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+
+	var elem strings.Builder
+	for i := 0; ; i++ {
+		elem.Reset()
+		body = strings.TrimLeft(body, " \t\n\r")
+		quoted := len(body) > 0 && body[0] == '"'
+		if quoted {
+			j := 1
+			for ; j < len(body) && body[j] != '"'; j++ {
+				if body[j] == '\\' && j+1 < len(body) {
+					j++
+				}
+				elem.WriteByte(body[j])
+			}
+			if j == len(body) {
+				return fmt.Errorf("element %d: unterminated quotes", i)
+			}
+			body = strings.TrimLeft(body[j+1:], " \t\n\r")
+		} else {
+			j := strings.IndexByte(body, ',')
+			if j < 0 {
+				j = len(body)
+			}
+			elem.WriteString(strings.TrimRight(body[:j], " \t\n\r"))
+			body = body[j:]
+		}
+		s := elem.String()
+		if !quoted && strings.ContainsAny(s, `{}"`) {
+			return fmt.Errorf("element %d: multidimensional arrays are not supported", i)
+		}
+		if err := fn(i, s, !quoted && strings.EqualFold(s, "NULL")); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		if body == "" {
+			return nil
+		}
+		if body[0] != ',' {
+			return fmt.Errorf("element %d: expected comma", i)
+		}
+		body = body[1:]
+	}
+}
+
+// appendArrayElem appends s to b as an element of a PostgreSQL array literal,
+// quoting it if it is empty, spells NULL, or contains whitespace or characters
+// with a meaning in array literals.
+func appendArrayElem(b []byte, s string) []byte {
+	if s != "" && !strings.EqualFold(s, "NULL") && !strings.ContainsAny(s, "{},\"\\ \t\n\r\v\f") {
+		return append(b, s...)
+	}
+	b = append(b, '"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b = append(b, '\\')
+		}
+		b = append(b, s[i])
+	}
+	return append(b, '"')
+}
@@ -0,0 +1,30 @@
+package types
+
+import "bytes"
+
+// JSONAppender is implemented by every type in this package. AppendJSON
+// appends the same JSON as MarshalJSON to b.
+type JSONAppender interface {
+	AppendJSON(b []byte) []byte
+}
+
+// AppendJSONArray appends values to b as a JSON array. Reusing b across calls
+// encodes large result sets without allocating per value.
+func AppendJSONArray[T JSONAppender](b []byte, values []T) []byte {
+	b = append(b, '[')
+	for i, v := range values {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = v.AppendJSON(b)
+	}
+	return append(b, ']')
+}
+
+// MarshalAllJSON writes values to dst as a JSON array, encoding directly into
+// dst's spare capacity. A dst that is Reset and reused between batches grows
+// once and then no longer allocates.
+func MarshalAllJSON[T JSONAppender](dst *bytes.Buffer, values ...T) {
+	// Write copies from the buffer's own spare capacity, so this does not allocate.
+	dst.Write(AppendJSONArray(dst.AvailableBuffer(), values))
+}
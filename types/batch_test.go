@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// appendJSONArrayMatches checks that AppendJSONArray produces the same JSON as json.Marshal.
+func appendJSONArrayMatches[T types.JSONAppender](t *testing.T, values []T) {
+	t.Helper()
+	want, err := json.Marshal(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := types.AppendJSONArray(nil, values); string(got) != string(want) {
+		t.Errorf("AppendJSONArray(%v) = %s, want %s", values, got, want)
+	}
+}
+
+func TestAppendJSONArray(t *testing.T) {
+	day := types.NewDate(time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC))
+	clock := types.NewTime(time.Date(1, time.January, 1, 23, 59, 0, 0, time.UTC))
+
+	t.Run("Date", func(t *testing.T) { appendJSONArrayMatches(t, []types.Date{day, {}}) })
+	t.Run("Time", func(t *testing.T) { appendJSONArrayMatches(t, []types.Time{clock, {}}) })
+	t.Run("CompactDate", func(t *testing.T) {
+		appendJSONArrayMatches(t, []types.CompactDate{day.Compact(), {}})
+	})
+	t.Run("CompactTime", func(t *testing.T) {
+		appendJSONArrayMatches(t, []types.CompactTime{clock.Compact(), {}})
+	})
+	t.Run("empty", func(t *testing.T) { appendJSONArrayMatches(t, []types.String{}) })
+}
@@ -0,0 +1,256 @@
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strconv"
+)
+
+// Bits is a nullable bit string, as stored in PostgreSQL bit(n) and varbit(n)
+// columns. It is scanned from and encoded in JSON as a string of binary digits,
+// such as "1010", bit 0 being the leftmost.
+//
+// The zero Bits is NULL; NewBits and parsing yield a valid, possibly empty, bit
+// string. An empty string is a valid empty bit string, not NULL. Methods never
+// modify bits shared with another Bits value, so Bits can be copied freely.
+//
+// The length is checked by ValidateStruct against the len option of the
+// `types` tag for bit(n) columns, or the maxlen option for varbit(n):
+//
+//	type Flags struct {
+//		Mask types.Bits `json:"mask" types:"len=8"`
+//	}
+type Bits struct {
+	bits  []byte // packed, most significant bit first; padding bits are 0
+	n     int
+	valid bool
+}
+
+// NewBits returns a valid Bits of n zero bits.
+func NewBits(n int) Bits {
+	return Bits{bits: make([]byte, (n+7)/8), n: n, valid: true}
+}
+
+// ParseBits parses a string of binary digits, such as "1010".
+func ParseBits(s string) (Bits, error) {
+	var b Bits
+	err := b.parseBits(s)
+	return b, err
+}
+
+// NullBits returns a NULL Bits. It is equivalent to Bits{}.
+func NullBits() Bits {
+	return Bits{}
+}
+
+// parseBits parses a string of binary digits into the Bits.
+func (b *Bits) parseBits(s string) error {
+	out := NewBits(len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '0':
+		case '1':
+			out.bits[i/8] |= 0x80 >> (i % 8)
+		default:
+			return invalidFormat("Bits", s, "binary digits", fmt.Errorf("invalid digit %q", s[i]))
+		}
+	}
+	*b = out
+	return nil
+}
+
+// Valid reports whether the Bits is non-NULL.
+func (b Bits) Valid() bool {
+	return b.valid
+}
+
+// Len returns the number of bits.
+func (b Bits) Len() int {
+	return b.n
+}
+
+// Test reports whether bit i is set. It panics if i is out of range.
+func (b Bits) Test(i int) bool {
+	b.checkIndex(i)
+	return b.bits[i/8]&(0x80>>(i%8)) != 0
+}
+
+// Set sets bit i to v. It panics if i is out of range.
+func (b *Bits) Set(i int, v bool) {
+	b.checkIndex(i)
+	// Copied so that a Bits sharing the bytes is not modified.
+	b.bits = bytes.Clone(b.bits)
+	if v {
+		b.bits[i/8] |= 0x80 >> (i % 8)
+	} else {
+		b.bits[i/8] &^= 0x80 >> (i % 8)
+	}
+}
+
+// checkIndex panics if i is not the index of a bit.
+func (b Bits) checkIndex(i int) {
+	if i < 0 || i >= b.n {
+		panic("types: Bits index " + strconv.Itoa(i) + " out of range [0:" + strconv.Itoa(b.n) + "]")
+	}
+}
+
+// appendBits appends the bits as binary digits to dst.
+func (b Bits) appendBits(dst []byte) []byte {
+	for i := 0; i < b.n; i++ {
+		if b.bits[i/8]&(0x80>>(i%8)) != 0 {
+			dst = append(dst, '1')
+		} else {
+			dst = append(dst, '0')
+		}
+	}
+	return dst
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a bit or varbit value in text form, such as 1010, handling NULL.
+// sql.RawBytes is accepted too.
+func (b *Bits) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*b = Bits{}
+		return nil
+	case string:
+		return b.parseBits(v)
+	case []byte:
+		return b.parseBits(string(v))
+	default:
+		return unsupportedScanType("Bits", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the bits as a string of binary digits, or nil if NULL.
+func (b Bits) Value() (driver.Value, error) {
+	if !b.valid {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the bits as a JSON string of binary digits, or null if NULL.
+func (b Bits) MarshalJSON() ([]byte, error) {
+	return b.AppendJSON(make([]byte, 0, b.n+2)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Bits to b, as returned by MarshalJSON.
+func (b Bits) AppendJSON(dst []byte) []byte {
+	if !b.valid {
+		return appendNullJSON(dst, zeroStringJSON)
+	}
+	dst = append(dst, '"')
+	dst = b.appendBits(dst)
+	return append(dst, '"')
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a JSON string of binary digits into the Bits, handling null.
+func (b *Bits) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*b = Bits{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("Bits", string(data), "JSON string", err)
+	}
+	return b.parseBits(s)
+}
+
+// IsZero reports whether the Bits is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL Bits is zero.
+func (b Bits) IsZero() bool {
+	return isZero(b.valid, b.n == 0)
+}
+
+// IsNull reports whether the Bits is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (b Bits) IsNull() bool {
+	return !b.valid
+}
+
+// HasValue reports whether the Bits is valid (non-NULL). It is the negation of IsNull.
+func (b Bits) HasValue() bool {
+	return b.valid
+}
+
+// Equal reports whether b and other hold the same bits, or are both NULL.
+// An empty Bits is not equal to a NULL one.
+func (b Bits) Equal(other Bits) bool {
+	if !b.valid || !other.valid {
+		return b.valid == other.valid
+	}
+	return b.n == other.n && bytes.Equal(b.bits, other.bits)
+}
+
+// Validate always returns nil, as a Bits can only be assembled by its methods.
+func (b Bits) Validate() error {
+	return nil
+}
+
+// validateTag checks the len and maxlen options of the `types` tag for ValidateStruct.
+func (b Bits) validateTag(tag reflect.StructTag) error {
+	if !b.valid {
+		return nil
+	}
+	if opt, ok := typesTagOption(tag, "len"); ok {
+		if n, err := strconv.Atoi(opt); err != nil || b.n != n {
+			return invalidFormat("Bits", b.String(), "bit("+opt+")", fmt.Errorf("length %d", b.n))
+		}
+	}
+	if opt, ok := typesTagOption(tag, "maxlen"); ok {
+		if n, err := strconv.Atoi(opt); err != nil || b.n > n {
+			return invalidFormat("Bits", b.String(), "varbit("+opt+")", fmt.Errorf("length %d", b.n))
+		}
+	}
+	return nil
+}
+
+// String returns the bits as binary digits, or an empty string if NULL.
+// Implements the fmt.Stringer interface.
+func (b Bits) String() string {
+	return string(b.appendBits(make([]byte, 0, b.n)))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// A NULL Bits is encoded as empty text, like an empty one.
+func (b Bits) MarshalText() ([]byte, error) {
+	return b.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the bits as binary digits to dst.
+func (b Bits) AppendText(dst []byte) ([]byte, error) {
+	return b.appendBits(dst), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text yields a valid empty Bits.
+func (b *Bits) UnmarshalText(text []byte) error {
+	return b.parseBits(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Bits.
+func (b *Bits) UnmarshalParam(param string) error {
+	return b.parseBits(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the bits as binary digits, or nil if NULL.
+func (b Bits) LogValue() slog.Value {
+	if !b.valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(b.String())
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// BoolSlice is a nullable slice of bools, e.g. for per-day or per-option flags.
+// It is stored in PostgreSQL as a boolean[] and encoded in JSON as an array of
+// booleans.
+//
+// A NULL BoolSlice differs from an empty one. NULL elements, which a []bool
+// cannot hold, are rejected when scanning and decoding.
+type BoolSlice struct {
+	Val   []bool
+	Valid bool
+}
+
+// NewBoolSlice returns a valid BoolSlice holding vals.
+func NewBoolSlice(vals ...bool) BoolSlice {
+	if vals == nil {
+		vals = []bool{}
+	}
+	return BoolSlice{Val: vals, Valid: true}
+}
+
+// NullBoolSlice returns a NULL BoolSlice. It is equivalent to BoolSlice{}.
+func NullBoolSlice() BoolSlice {
+	return BoolSlice{}
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL boolean[] in text form, such as {t,f,t}, handling NULL.
+// Elements may be spelled as accepted by strconv.ParseBool, e.g. true or 0.
+func (s *BoolSlice) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*s = BoolSlice{}
+		return nil
+	case []byte:
+		return s.parseArray(string(v))
+	case string:
+		return s.parseArray(v)
+	default:
+		return unsupportedScanType("BoolSlice", value)
+	}
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal of booleans.
+func (s *BoolSlice) parseArray(text string) error {
+	vals := []bool{}
+	err := scanArrayLiteral(text, func(_ int, elem string, null bool) error {
+		if null {
+			return errNullElement
+		}
+		v, err := strconv.ParseBool(elem)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, v)
+		return nil
+	})
+	if err != nil {
+		return invalidFormat("BoolSlice", text, "boolean[] literal", err)
+	}
+	*s = BoolSlice{Val: vals, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the slice as a compact PostgreSQL boolean[] literal, or nil if NULL.
+func (s BoolSlice) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// String returns the slice as a PostgreSQL array literal, e.g. {t,f,t}, or an
+// empty string if NULL.
+func (s BoolSlice) String() string {
+	if !s.Valid {
+		return ""
+	}
+	b := make([]byte, 0, 1+len(s.Val)*2)
+	b = append(b, '{')
+	for i, v := range s.Val {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if v {
+			b = append(b, 't')
+		} else {
+			b = append(b, 'f')
+		}
+	}
+	return string(append(b, '}'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the slice as a JSON array of booleans, or null if NULL.
+func (s BoolSlice) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, 2+len(s.Val)*6)), nil
+}
+
+// AppendJSON appends the JSON encoding of the BoolSlice to b, as returned by MarshalJSON.
+func (s BoolSlice) AppendJSON(b []byte) []byte {
+	if !s.Valid {
+		return appendNullJSON(b, zeroArrayJSON)
+	}
+	b = append(b, '[')
+	for i, v := range s.Val {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = strconv.AppendBool(b, v)
+	}
+	return append(b, ']')
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of booleans, handling null.
+func (s *BoolSlice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = BoolSlice{}
+		return nil
+	}
+	var elems []*bool
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return invalidFormat("BoolSlice", string(data), "JSON array of booleans", err)
+	}
+	vals := make([]bool, len(elems))
+	for i, v := range elems {
+		if v == nil {
+			return invalidFormat("BoolSlice", string(data), "JSON array of booleans", fmt.Errorf("element %d: %w", i, errNullElement))
+		}
+		vals[i] = *v
+	}
+	*s = BoolSlice{Val: vals, Valid: true}
+	return nil
+}
+
+// IsZero reports whether the BoolSlice is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL BoolSlice is zero.
+func (s BoolSlice) IsZero() bool {
+	return isZero(s.Valid, len(s.Val) == 0)
+}
+
+// IsNull reports whether the BoolSlice is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s BoolSlice) IsNull() bool {
+	return !s.Valid
+}
+
+// HasValue reports whether the BoolSlice is valid (non-NULL). It is the negation of IsNull.
+func (s BoolSlice) HasValue() bool {
+	return s.Valid
+}
+
+// Equal reports whether s and other hold the same bools, or are both NULL.
+// An empty slice is not equal to a NULL one.
+func (s BoolSlice) Equal(other BoolSlice) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+	return slices.Equal(s.Val, other.Val)
+}
+
+// Validate always returns nil, as every []bool can be stored in a boolean[].
+func (s BoolSlice) Validate() error {
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// timeBounds is the inclusive range of Dates and Timestamps accepted when
+// parsing, as selected with SetBounds.
+type timeBounds struct {
+	min, max time.Time
+}
+
+// defaultBounds are years 1 through 9999, the range of most databases' date
+// and timestamp types and of RFC3339.
+var defaultBounds = timeBounds{
+	min: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+	max: time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+}
+
+var bounds atomic.Pointer[timeBounds]
+
+// SetBounds selects the inclusive range of Dates and Timestamps accepted by
+// Scan, UnmarshalJSON, UnmarshalText and the other parsing methods, so values
+// out of the database's range are rejected when parsed rather than when
+// inserted. Infinite values are always accepted. The default is years 1
+// through 9999; for PostgreSQL's full range use:
+//
+//	types.SetBounds(
+//		time.Date(-4712, time.January, 1, 0, 0, 0, 0, time.UTC),
+//		time.Date(294276, time.December, 31, 23, 59, 59, 999999999, time.UTC),
+//	)
+//
+// Validate reports values out of this range as well.
+func SetBounds(min, max time.Time) {
+	bounds.Store(&timeBounds{min: min, max: max})
+}
+
+// currentBounds returns the bounds selected with SetBounds.
+func currentBounds() *timeBounds {
+	if b := bounds.Load(); b != nil {
+		return b
+	}
+	return &defaultBounds
+}
+
+// checkBounds returns an *ErrInvalidFormat for typ if t lies outside the bounds
+// selected with SetBounds and is not infinite. input is the text t was parsed
+// from, or empty to use t itself.
+func checkBounds(typ string, t time.Time, input string) error {
+	b := currentBounds()
+	if (!t.Before(b.min) && !t.After(b.max)) || t.Equal(infinityTime) || t.Equal(negInfinityTime) {
+		return nil
+	}
+	if input == "" {
+		input = t.Format(time.RFC3339Nano)
+	}
+	err := fmt.Errorf("out of range [%s, %s]", b.min.Format(time.RFC3339), b.max.Format(time.RFC3339))
+	if typ == "Date" {
+		return invalidFormat(typ, input, "YYYY-MM-DD", err)
+	}
+	return invalidFormat(typ, input, "RFC3339", err)
+}
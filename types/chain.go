@@ -0,0 +1,78 @@
+package types
+
+import "time"
+
+// WithValid returns a copy of the Date with Valid set to valid.
+func (d Date) WithValid(valid bool) Date {
+	d.Valid = valid
+	return d
+}
+
+// OrNullIfZero returns an invalid Date if the Date holds the zero time, as
+// produced by a zero time.Time, and the Date unchanged otherwise.
+func (d Date) OrNullIfZero() Date {
+	if d.Time.IsZero() {
+		return Date{}
+	}
+	return d
+}
+
+// WithValid returns a copy of the Time with Valid set to valid.
+func (t Time) WithValid(valid bool) Time {
+	t.Valid = valid
+	return t
+}
+
+// Truncated returns the Time rounded down to a multiple of d since midnight,
+// e.g. to the quarter hour. An invalid Time is returned unchanged.
+func (t Time) Truncated(d time.Duration) Time {
+	if !t.Valid {
+		return t
+	}
+	return NewTime(t.Time.Truncate(d))
+}
+
+// WithValid returns a copy of the Timestamp with Valid set to valid.
+func (t Timestamp) WithValid(valid bool) Timestamp {
+	t.Valid = valid
+	return t
+}
+
+// OrNullIfZero returns an invalid Timestamp if the Timestamp holds the zero
+// time, as produced by a zero time.Time, and the Timestamp unchanged otherwise.
+func (t Timestamp) OrNullIfZero() Timestamp {
+	if t.Time.IsZero() {
+		return Timestamp{}
+	}
+	return t
+}
+
+// Truncated returns the Timestamp rounded down to a multiple of d, e.g.
+// time.Minute, as time.Time.Truncate does. Invalid and infinite Timestamps are
+// returned unchanged.
+func (t Timestamp) Truncated(d time.Duration) Timestamp {
+	if _, inf := infinityString(t.Time, t.Valid); inf || !t.Valid {
+		return t
+	}
+	t.Time = t.Time.Truncate(d)
+	return t
+}
+
+// WithValid returns a copy of the String with Valid set to valid.
+func (s String) WithValid(valid bool) String {
+	s.Valid = valid
+	return s
+}
+
+// OrNullIfEmpty returns an invalid String if the String holds the empty
+// string, and the String unchanged otherwise. Like the other chaining helpers,
+// it allows building values inline:
+//
+//	name := types.NewString(in.Name).OrNullIfEmpty()
+//	seen := types.NewTimestamp(in.SeenAt).OrNullIfZero().Truncated(time.Minute)
+func (s String) OrNullIfEmpty() String {
+	if s.Val == "" {
+		return String{}
+	}
+	return s
+}
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+)
+
+// Char is a nullable single character, e.g. for one-letter codes stored in
+// char(1) columns. It is stored and encoded in JSON as a one-character string.
+//
+// Parsing rejects input of more than one rune and invalid UTF-8. Empty text
+// yields an invalid Char.
+type Char struct {
+	Val   rune
+	Valid bool
+}
+
+// NewChar creates a new valid Char.
+func NewChar(r rune) Char {
+	return Char{Val: r, Valid: true}
+}
+
+// ParseChar parses a one-character string. An empty string yields an invalid Char.
+func ParseChar(s string) (Char, error) {
+	var c Char
+	err := c.parseChar(s)
+	return c, err
+}
+
+// NullChar returns an invalid (NULL) Char. It is equivalent to Char{}.
+func NullChar() Char {
+	return Char{}
+}
+
+// parseChar parses s into the Char, marking it invalid if s is empty.
+func (c *Char) parseChar(s string) error {
+	if s == "" {
+		*c = Char{}
+		return nil
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError && size <= 1 {
+		return invalidFormat("Char", s, "single character", errors.New("invalid UTF-8"))
+	}
+	if size != len(s) {
+		return invalidFormat("Char", s, "single character", fmt.Errorf("got %d characters", utf8.RuneCountInString(s)))
+	}
+	*c = Char{Val: r, Valid: true}
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses database values into a Char, supporting NULL, string, and []byte.
+// sql.RawBytes is accepted too.
+func (c *Char) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*c = Char{}
+		return nil
+	case string:
+		return c.parseChar(v)
+	case []byte:
+		return c.parseChar(string(v))
+	default:
+		return unsupportedScanType("Char", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the Char as a one-character string, or nil if invalid.
+func (c Char) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return string(c.Val), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the Char as a one-character JSON string, or null if invalid.
+func (c Char) MarshalJSON() ([]byte, error) {
+	return c.AppendJSON(make([]byte, 0, 8)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Char to b, as returned by MarshalJSON.
+func (c Char) AppendJSON(b []byte) []byte {
+	if !c.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	return appendJSONString(b, string(c.Val))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a one-character JSON string into the Char, handling null and empty strings.
+func (c *Char) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = Char{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("Char", string(data), "JSON string", err)
+	}
+	return c.parseChar(s)
+}
+
+// IsZero reports whether the Char is invalid or the NUL character.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid Char is zero.
+func (c Char) IsZero() bool {
+	return isZero(c.Valid, c.Val == 0)
+}
+
+// IsNull reports whether the Char is invalid (NULL).
+func (c Char) IsNull() bool {
+	return !c.Valid
+}
+
+// HasValue reports whether the Char is valid (non-NULL). It is the negation of IsNull.
+func (c Char) HasValue() bool {
+	return c.Valid
+}
+
+// Equal reports whether c and other hold the same character, or are both invalid.
+func (c Char) Equal(other Char) bool {
+	return c == other || (!c.Valid && !other.Valid)
+}
+
+// Validate returns an *ErrInvalidFormat if the Char is valid but holds a NUL
+// or a rune that cannot be encoded in UTF-8, such as a surrogate half.
+func (c Char) Validate() error {
+	if !c.Valid {
+		return nil
+	}
+	if c.Val == 0 || !utf8.ValidRune(c.Val) {
+		return invalidFormat("Char", fmt.Sprintf("%U", c.Val), "single character", errors.New("invalid rune"))
+	}
+	return nil
+}
+
+// String returns the character, or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (c Char) String() string {
+	if !c.Valid {
+		return ""
+	}
+	return string(c.Val)
+}
+
+// Set implements the flag.Value interface.
+// It parses a one-character string into the Char, marking it invalid if the string is empty.
+func (c *Char) Set(s string) error {
+	return c.parseChar(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (c *Char) Type() string {
+	return "char"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Char is encoded as empty text.
+func (c Char) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the character to b, or nothing if invalid.
+func (c Char) AppendText(b []byte) ([]byte, error) {
+	if !c.Valid {
+		return b, nil
+	}
+	return utf8.AppendRune(b, c.Val), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the Char invalid.
+func (c *Char) UnmarshalText(text []byte) error {
+	return c.parseChar(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Char.
+func (c *Char) UnmarshalParam(param string) error {
+	return c.parseChar(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the Char as a string, or nil if invalid.
+func (c Char) LogValue() slog.Value {
+	if !c.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(c.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (c Char) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Char", "Val", c.String(), c.Valid)
+}
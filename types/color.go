@@ -0,0 +1,261 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"log/slog"
+)
+
+// Color is a nullable RGB color with optional alpha, e.g. for theming and
+// branding columns. It is stored and encoded in JSON as a hex string.
+//
+// Parsing accepts "#RGB", "#RGBA", "#RRGGBB" and "#RRGGBBAA" in either case.
+// Output is lowercase "#rrggbb", or "#rrggbbaa" if the color is not opaque.
+// Empty text yields an invalid Color. Color implements color.Color.
+type Color struct {
+	Val   color.NRGBA
+	Valid bool
+}
+
+// NewColor creates a new valid Color from a color.Color.
+func NewColor(c color.Color) Color {
+	return Color{Val: color.NRGBAModel.Convert(c).(color.NRGBA), Valid: true}
+}
+
+// ParseColor parses a hex color such as "#1e90ff". An empty string yields an
+// invalid Color.
+func ParseColor(s string) (Color, error) {
+	var c Color
+	err := c.parseColor(s)
+	return c, err
+}
+
+// NullColor returns an invalid (NULL) Color. It is equivalent to Color{}.
+func NullColor() Color {
+	return Color{}
+}
+
+// parseColor parses s into the Color, marking it invalid if s is empty.
+func (c *Color) parseColor(s string) error {
+	if s == "" {
+		*c = Color{}
+		return nil
+	}
+	if len(s) < 2 || len(s) > 9 || s[0] != '#' {
+		return invalidFormat("Color", s, "#RRGGBB", nil)
+	}
+	var buf [8]byte
+	digits := buf[:len(s)-1]
+	for i := range digits {
+		d, ok := unhex(s[i+1])
+		if !ok {
+			return invalidFormat("Color", s, "#RRGGBB", nil)
+		}
+		digits[i] = d
+	}
+	v := color.NRGBA{A: 0xff}
+	switch len(digits) {
+	case 3, 4:
+		v.R, v.G, v.B = digits[0]*0x11, digits[1]*0x11, digits[2]*0x11
+		if len(digits) == 4 {
+			v.A = digits[3] * 0x11
+		}
+	case 6, 8:
+		v.R, v.G, v.B = digits[0]<<4|digits[1], digits[2]<<4|digits[3], digits[4]<<4|digits[5]
+		if len(digits) == 8 {
+			v.A = digits[6]<<4 | digits[7]
+		}
+	default:
+		return invalidFormat("Color", s, "#RRGGBB", nil)
+	}
+	*c = Color{Val: v, Valid: true}
+	return nil
+}
+
+// unhex returns the value of the hex digit b.
+func unhex(b byte) (byte, bool) {
+	switch {
+	case '0' <= b && b <= '9':
+		return b - '0', true
+	case 'a' <= b && b <= 'f':
+		return b - 'a' + 10, true
+	case 'A' <= b && b <= 'F':
+		return b - 'A' + 10, true
+	default:
+		return 0, false
+	}
+}
+
+// appendColor appends v as "#rrggbb", or "#rrggbbaa" if v is not opaque.
+func appendColor(b []byte, v color.NRGBA) []byte {
+	const hex = "0123456789abcdef"
+	components := []uint8{v.R, v.G, v.B, v.A}
+	if v.A == 0xff {
+		components = components[:3]
+	}
+	b = append(b, '#')
+	for _, x := range components {
+		b = append(b, hex[x>>4], hex[x&0xf])
+	}
+	return b
+}
+
+// RGBA implements the color.Color interface, returning alpha-premultiplied
+// components as color.NRGBA does. An invalid Color is transparent black.
+// Use Val for the non-premultiplied 8-bit components.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	if !c.Valid {
+		return 0, 0, 0, 0
+	}
+	return c.Val.RGBA()
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses database values into a Color, supporting NULL, string, and []byte.
+// sql.RawBytes is accepted too.
+func (c *Color) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*c = Color{}
+		return nil
+	case string:
+		return c.parseColor(v)
+	case []byte:
+		return c.parseColor(string(v))
+	default:
+		return unsupportedScanType("Color", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the color as a hex string, or nil if invalid.
+func (c Color) Value() (driver.Value, error) {
+	if !c.Valid {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the color as a hex JSON string, or null if invalid.
+func (c Color) MarshalJSON() ([]byte, error) {
+	return c.AppendJSON(make([]byte, 0, 11)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Color to b, as returned by MarshalJSON.
+func (c Color) AppendJSON(b []byte) []byte {
+	if !c.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	b = append(b, '"')
+	b = appendColor(b, c.Val)
+	return append(b, '"')
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a JSON string into the Color, handling null and empty strings.
+func (c *Color) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = Color{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("Color", string(data), "JSON string", err)
+	}
+	return c.parseColor(s)
+}
+
+// IsZero reports whether the Color is invalid or transparent black.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid Color is zero.
+func (c Color) IsZero() bool {
+	return isZero(c.Valid, c.Val == color.NRGBA{})
+}
+
+// IsNull reports whether the Color is invalid (NULL).
+func (c Color) IsNull() bool {
+	return !c.Valid
+}
+
+// HasValue reports whether the Color is valid (non-NULL). It is the negation of IsNull.
+func (c Color) HasValue() bool {
+	return c.Valid
+}
+
+// Equal reports whether c and other are the same color, or both invalid.
+func (c Color) Equal(other Color) bool {
+	if !c.Valid || !other.Valid {
+		return c.Valid == other.Valid
+	}
+	return c.Val == other.Val
+}
+
+// Validate returns nil: every valid Color has a hex form.
+func (c Color) Validate() error {
+	return nil
+}
+
+// String returns the color as "#rrggbb" or "#rrggbbaa", or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (c Color) String() string {
+	if !c.Valid {
+		return ""
+	}
+	return string(appendColor(make([]byte, 0, 9), c.Val))
+}
+
+// Set implements the flag.Value interface.
+// It parses a hex color into the Color, marking it invalid if the string is empty.
+func (c *Color) Set(s string) error {
+	return c.parseColor(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (c *Color) Type() string {
+	return "color"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Color is encoded as empty text.
+func (c Color) MarshalText() ([]byte, error) {
+	return c.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the Color as returned by String to b.
+func (c Color) AppendText(b []byte) ([]byte, error) {
+	if !c.Valid {
+		return b, nil
+	}
+	return appendColor(b, c.Val), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the Color invalid.
+func (c *Color) UnmarshalText(text []byte) error {
+	return c.parseColor(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Color.
+func (c *Color) UnmarshalParam(param string) error {
+	return c.parseColor(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the Color as a hex string, or nil if invalid.
+func (c Color) LogValue() slog.Value {
+	if !c.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(c.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (c Color) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Color", "Val", c.String(), c.Valid)
+}
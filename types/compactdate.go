@@ -0,0 +1,136 @@
+package types
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// CompactDate is a memory-efficient alternative to Date for large in-memory
+// collections such as caches. It stores the number of days since 1970-01-01
+// in an int32, so it is 8 bytes instead of 32, comparable with == and usable
+// as a map key. The zero value is invalid (NULL).
+//
+// CompactDate converts to and from Date, and delegates its database and JSON
+// encoding to Date, so both types read and write the same representations.
+type CompactDate struct {
+	days  int32
+	valid bool
+}
+
+// NewCompactDate creates a valid CompactDate from the calendar date of t in t's location.
+func NewCompactDate(t time.Time) CompactDate {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return CompactDate{days: int32(midnight.Unix() / secondsPerDay), valid: true}
+}
+
+// Compact returns the Date as a CompactDate.
+func (d Date) Compact() CompactDate {
+	if !d.Valid {
+		return CompactDate{}
+	}
+	return NewCompactDate(d.Time)
+}
+
+// Date returns the CompactDate as a Date.
+func (c CompactDate) Date() Date {
+	if !c.valid {
+		return Date{}
+	}
+	return Date{Time: c.Time(), Valid: true}
+}
+
+// Time returns midnight UTC of the date, or the zero time if invalid.
+func (c CompactDate) Time() time.Time {
+	if !c.valid {
+		return time.Time{}
+	}
+	return time.Unix(int64(c.days)*secondsPerDay, 0).UTC()
+}
+
+// Valid reports whether the CompactDate holds a date, as opposed to NULL.
+func (c CompactDate) Valid() bool {
+	return c.valid
+}
+
+// IsNull reports whether the CompactDate is invalid (NULL).
+func (c CompactDate) IsNull() bool {
+	return !c.valid
+}
+
+// HasValue reports whether the CompactDate is valid (non-NULL). It is the negation of IsNull.
+func (c CompactDate) HasValue() bool {
+	return c.valid
+}
+
+// Compare returns -1, 0 or +1 depending on whether c is before, equal to or
+// after other. Invalid dates sort before all valid dates.
+func (c CompactDate) Compare(other CompactDate) int {
+	switch {
+	case c == other:
+		return 0
+	case !c.valid:
+		return -1
+	case !other.valid:
+		return 1
+	case c.days < other.days:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Scan implements the sql.Scanner interface, accepting the same values as Date.Scan.
+func (c *CompactDate) Scan(value any) error {
+	var d Date
+	if err := d.Scan(value); err != nil {
+		return err
+	}
+	*c = d.Compact()
+	return nil
+}
+
+// Value implements the driver.Valuer interface, producing the same value as Date.Value.
+func (c CompactDate) Value() (driver.Value, error) {
+	return c.Date().Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface, producing the same JSON as Date.
+func (c CompactDate) MarshalJSON() ([]byte, error) {
+	return c.Date().MarshalJSON()
+}
+
+// AppendJSON appends the JSON encoding of the CompactDate to b, as returned by MarshalJSON.
+func (c CompactDate) AppendJSON(b []byte) []byte {
+	return c.Date().AppendJSON(b)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the same JSON as Date.
+func (c *CompactDate) UnmarshalJSON(data []byte) error {
+	var d Date
+	if err := d.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*c = d.Compact()
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c CompactDate) MarshalText() ([]byte, error) {
+	return c.Date().MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *CompactDate) UnmarshalText(text []byte) error {
+	var d Date
+	if err := d.UnmarshalText(text); err != nil {
+		return err
+	}
+	*c = d.Compact()
+	return nil
+}
+
+// String returns the date formatted as YYYY-MM-DD, or an empty string if invalid.
+func (c CompactDate) String() string {
+	return c.Date().String()
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+// CompactTime is a memory-efficient alternative to Time for large slices and
+// map keys. It stores the seconds since midnight in an int32, so it is 8 bytes
+// instead of 32 and comparable with ==. Like Time, it has minute precision.
+// The zero value is invalid (NULL).
+//
+// CompactTime converts to and from Time, and delegates its database and JSON
+// encoding to Time, so both types read and write the same representations.
+type CompactTime struct {
+	secs  int32
+	valid bool
+}
+
+// NewCompactTime creates a valid CompactTime from the time of day of t,
+// dropping seconds.
+func NewCompactTime(t time.Time) CompactTime {
+	h, m, _ := t.Clock()
+	return CompactTime{secs: int32(h*3600 + m*60), valid: true}
+}
+
+// Compact returns the Time as a CompactTime.
+func (t Time) Compact() CompactTime {
+	if !t.Valid {
+		return CompactTime{}
+	}
+	return NewCompactTime(t.Time)
+}
+
+// Time returns the CompactTime as a Time.
+func (c CompactTime) Time() Time {
+	if !c.valid {
+		return Time{}
+	}
+	return Time{Time: c.Clock(), Valid: true}
+}
+
+// Clock returns the time of day on January 1st of year 1, UTC, matching the
+// Time field of Time, or the zero time if invalid.
+func (c CompactTime) Clock() time.Time {
+	if !c.valid {
+		return time.Time{}
+	}
+	return time.Time{}.Add(time.Duration(c.secs) * time.Second)
+}
+
+// Seconds returns the number of seconds since midnight, or 0 if invalid.
+func (c CompactTime) Seconds() int {
+	return int(c.secs)
+}
+
+// Valid reports whether the CompactTime holds a time of day, as opposed to NULL.
+func (c CompactTime) Valid() bool {
+	return c.valid
+}
+
+// IsNull reports whether the CompactTime is invalid (NULL).
+func (c CompactTime) IsNull() bool {
+	return !c.valid
+}
+
+// HasValue reports whether the CompactTime is valid (non-NULL). It is the negation of IsNull.
+func (c CompactTime) HasValue() bool {
+	return c.valid
+}
+
+// Compare returns -1, 0 or +1 depending on whether c is before, equal to or
+// after other. Invalid times sort before all valid times.
+func (c CompactTime) Compare(other CompactTime) int {
+	switch {
+	case c == other:
+		return 0
+	case !c.valid:
+		return -1
+	case !other.valid:
+		return 1
+	case c.secs < other.secs:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Scan implements the sql.Scanner interface, accepting the same values as Time.Scan.
+func (c *CompactTime) Scan(value any) error {
+	var t Time
+	if err := t.Scan(value); err != nil {
+		return err
+	}
+	*c = t.Compact()
+	return nil
+}
+
+// Value implements the driver.Valuer interface, producing the same value as Time.Value.
+func (c CompactTime) Value() (driver.Value, error) {
+	return c.Time().Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface, producing the same JSON as Time.
+func (c CompactTime) MarshalJSON() ([]byte, error) {
+	return c.Time().MarshalJSON()
+}
+
+// AppendJSON appends the JSON encoding of the CompactTime to b, as returned by MarshalJSON.
+func (c CompactTime) AppendJSON(b []byte) []byte {
+	return c.Time().AppendJSON(b)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting the same JSON as Time.
+func (c *CompactTime) UnmarshalJSON(data []byte) error {
+	var t Time
+	if err := t.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*c = t.Compact()
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c CompactTime) MarshalText() ([]byte, error) {
+	return c.Time().MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *CompactTime) UnmarshalText(text []byte) error {
+	var t Time
+	if err := t.UnmarshalText(text); err != nil {
+		return err
+	}
+	*c = t.Compact()
+	return nil
+}
+
+// String returns the time formatted as HH:MM, or an empty string if invalid.
+func (c CompactTime) String() string {
+	return c.Time().String()
+}
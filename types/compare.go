@@ -0,0 +1,92 @@
+package types
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to or
+// greater than b according to their Compare method, under which invalid (NULL)
+// values sort before all valid values. Use NullsLast to sort them last instead.
+//
+// The Compare methods can be passed to slices.SortFunc directly:
+//
+//	slices.SortFunc(dates, types.Date.Compare)
+//	slices.SortFunc(stamps, types.NullsLast[types.Timestamp])
+func Compare[T interface{ Compare(T) int }](a, b T) int {
+	return a.Compare(b)
+}
+
+// NullsLast is like Compare, but sorts invalid (NULL) values after all valid
+// values. It relies on the zero value of T being invalid, as for all types in
+// this package.
+func NullsLast[T interface{ Compare(T) int }](a, b T) int {
+	var null T
+	aNull, bNull := a.Compare(null) == 0, b.Compare(null) == 0
+	switch {
+	case aNull && bNull:
+		return 0
+	case aNull:
+		return 1
+	case bNull:
+		return -1
+	default:
+		return a.Compare(b)
+	}
+}
+
+// compareNull orders two values by validity alone, invalid first. ok is false
+// if both are valid and must be compared by value.
+func compareNull(aValid, bValid bool) (c int, ok bool) {
+	switch {
+	case aValid && bValid:
+		return 0, false
+	case aValid:
+		return 1, true
+	case bValid:
+		return -1, true
+	default:
+		return 0, true
+	}
+}
+
+// Compare returns -1, 0 or +1 depending on whether d is before, the same
+// calendar date as, or after other. Invalid dates sort before all valid dates,
+// and -infinity and infinity before and after all finite dates.
+func (d Date) Compare(other Date) int {
+	if c, ok := compareNull(d.Valid, other.Valid); ok {
+		return c
+	}
+	return cmp.Compare(compareSetDates(d, other), 0)
+}
+
+// Compare returns -1, 0 or +1 depending on whether t is earlier than, the same
+// as, or later than other in the day (HH:MM). Invalid times sort before all valid times.
+func (t Time) Compare(other Time) int {
+	if c, ok := compareNull(t.Valid, other.Valid); ok {
+		return c
+	}
+	th, tm, _ := t.Time.Clock()
+	oh, om, _ := other.Time.Clock()
+	return cmp.Compare(th*60+tm, oh*60+om)
+}
+
+// Compare returns -1, 0 or +1 depending on whether t is before, the same instant
+// as, or after other. Invalid timestamps sort before all valid timestamps, and
+// -infinity and infinity before and after all finite timestamps.
+func (t Timestamp) Compare(other Timestamp) int {
+	if c, ok := compareNull(t.Valid, other.Valid); ok {
+		return c
+	}
+	return t.Time.Compare(other.Time)
+}
+
+// Compare returns -1, 0 or +1 depending on whether s sorts before, the same as,
+// or after other, comparing bytewise like strings.Compare. Invalid strings sort
+// before all valid strings, including the empty string.
+func (s String) Compare(other String) int {
+	if c, ok := compareNull(s.Valid, other.Valid); ok {
+		return c
+	}
+	return strings.Compare(s.Val, other.Val)
+}
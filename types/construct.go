@@ -0,0 +1,42 @@
+package types
+
+// NewOption adjusts the normalization NewDate and NewTimestamp apply to their
+// argument, for callers that need to keep the exact time.Time they pass in
+// while still getting NULL semantics:
+//
+//	ts := types.NewTimestamp(t, types.WithoutUTC(), types.WithoutTruncation())
+//
+// Values built this way are not in normal form: they may not compare equal
+// with == to the same value read back from the database, and Date.Validate
+// reports a Date with a time of day. Scan, Value and the encoders are unaffected.
+type NewOption func(*newOptions)
+
+type newOptions struct {
+	keepLocation  bool
+	keepPrecision bool
+}
+
+// WithoutUTC makes NewTimestamp keep the location of its argument instead of
+// converting it to UTC. NewDate never converts, so it is unaffected.
+func WithoutUTC() NewOption {
+	return func(o *newOptions) {
+		o.keepLocation = true
+	}
+}
+
+// WithoutTruncation makes NewTimestamp keep fractional seconds, and NewDate
+// keep the time of day, instead of truncating them.
+func WithoutTruncation() NewOption {
+	return func(o *newOptions) {
+		o.keepPrecision = true
+	}
+}
+
+// applyNewOptions returns the options set by opts.
+func applyNewOptions(opts []NewOption) newOptions {
+	var o newOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -2,7 +2,10 @@ package types
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"time"
 )
 
@@ -15,14 +18,42 @@ type Date struct {
 // Defines the standard format for dates (YYYY-MM-DD).
 const dateFormat = "2006-01-02"
 
-// NewDate creates a new valid Date, truncating the time to midnight.
-func NewDate(t time.Time) Date {
+// NewDate creates a new valid Date, truncating the time to midnight
+// unless WithoutTruncation is given. Truncation is to midnight UTC, so
+// non-UTC instants may yield the previous or next day; use NewDateIn, or select
+// a location with SetNewDateLocation, to take the calendar date in a zone.
+func NewDate(t time.Time, opts ...NewOption) Date {
+	if len(opts) > 0 && applyNewOptions(opts).keepPrecision {
+		return Date{Time: t, Valid: true}
+	}
+	if loc := newDateLocation.Load(); loc != nil {
+		return NewDateIn(t, loc)
+	}
 	return Date{Time: t.Truncate(24 * time.Hour), Valid: true}
 }
 
+// NewDateIn creates a new valid Date holding the calendar date of t in loc,
+// e.g. NewDateIn(time.Now(), helsinki) for today in Helsinki, regardless of
+// DST. The Date is stored as midnight UTC.
+func NewDateIn(t time.Time, loc *time.Location) Date {
+	y, m, d := t.In(loc).Date()
+	return Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// NullDate returns an invalid (NULL) Date. It is equivalent to Date{}.
+func NullDate() Date {
+	return Date{}
+}
+
 // Scan implements the sql.Scanner interface.
 // It converts a database value into a Date, handling NULL, time.Time, []byte, and string inputs.
+// *time.Time and sql.RawBytes are accepted too, as are Unix seconds (int64 or json.Number)
+// and Julian day numbers (float64). A time.Time at midnight in its own location,
+// as returned for DATE columns by drivers using a local zone, yields that
+// calendar date. A time of day is discarded, or rejected as selected with
+// SetDateScanPolicy.
 func (d *Date) Scan(value any) error {
+	value = normalizeScanValue(value)
 	if value == nil {
 		d.Time, d.Valid = time.Time{}, false
 		return nil
@@ -30,55 +61,137 @@ func (d *Date) Scan(value any) error {
 
 	switch v := value.(type) {
 	case time.Time:
-		d.Time = v.Truncate(24 * time.Hour)
-		d.Valid = true
-		return nil
+		if mysqlZeroDates.Load() && v.IsZero() {
+			// go-sql-driver/mysql with parseTime=true returns zero dates as time.Time{}.
+			d.Time, d.Valid = time.Time{}, false
+			return nil
+		}
+		return d.scanDay(v, "")
+	case int64:
+		// Unix seconds, as commonly stored by SQLite.
+		return d.scanDay(time.Unix(v, 0).UTC(), strconv.FormatInt(v, 10))
+	case float64:
+		// Julian day number, as produced by SQLite's julianday().
+		return d.scanDay(julianDayToTime(v), strconv.FormatFloat(v, 'f', -1, 64))
+	case json.Number:
+		// Unix seconds, as decoded by json.Decoder.UseNumber.
+		n, err := v.Int64()
+		if err != nil {
+			return invalidFormat("Date", string(v), "Unix seconds", err)
+		}
+		return d.Scan(n)
 	case []byte:
-		return d.parseDateString(string(v))
+		return d.scanDateString(string(v))
 	case string:
-		return d.parseDateString(v)
+		return d.scanDateString(v)
 	default:
-		return fmt.Errorf("cannot scan %T into Date", value)
+		return unsupportedScanType("Date", value)
 	}
 }
 
+// scanDateString parses a string returned by the database driver.
+// Besides YYYY-MM-DD, it accepts the date formats of the dialect selected with SetDialect,
+// and MySQL zero dates if enabled with SetMySQLZeroDates.
+func (d *Date) scanDateString(s string) error {
+	if scanZeroDate(s) {
+		d.Time, d.Valid = time.Time{}, false
+		return nil
+	}
+	err := d.parseDateString(s)
+	if err == nil {
+		return nil
+	}
+	for _, layout := range currentProfile().dateLayouts {
+		if parsed, perr := time.ParseInLocation(layout, s, time.UTC); perr == nil {
+			return d.scanDay(parsed, s)
+		}
+	}
+	return err
+}
+
 // Parses a string in YYYY-MM-DD format into a Date.
 // If the string is empty, the Date is marked invalid.
+// PostgreSQL's "infinity" and "-infinity" are accepted as well.
 func (d *Date) parseDateString(s string) error {
 	if s == "" {
 		d.Time, d.Valid = time.Time{}, false
 		return nil
 	}
-	t, err := time.Parse(dateFormat, s)
-	if err != nil {
-		return fmt.Errorf("invalid date format, expected YYYY-MM-DD: %w", err)
+	if t, ok := parseInfinity(s); ok {
+		d.Time, d.Valid = t, true
+		return nil
+	}
+	if t, ok := parseDateFast(s); ok {
+		return d.setChecked(t, s)
 	}
-	d.Time = t
-	d.Valid = true
+	if layout := loadLayout(&dateLayout); layout != "" {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return d.setChecked(t.Truncate(24*time.Hour), s)
+		}
+	}
+	// Only for the error message, which explains what is wrong with s.
+	if _, err := time.Parse(dateFormat, s); err != nil {
+		return invalidFormat("Date", s, "YYYY-MM-DD", err)
+	}
+	return invalidFormat("Date", s, "YYYY-MM-DD", nil)
+}
+
+// setChecked sets the Date to t, parsed from input, if t lies within the
+// bounds selected with SetBounds.
+func (d *Date) setChecked(t time.Time, input string) error {
+	if err := checkBounds("Date", t, input); err != nil {
+		return err
+	}
+	d.Time, d.Valid = t, true
 	return nil
 }
 
 // Value implements the driver.Valuer interface.
-// It converts the Date into a database-compatible value (string or NULL).
+// It converts the Date into a database-compatible value (string or NULL),
+// or a time.Time if selected with SetDateValueKind.
+// With the SQLite dialect, the Date may instead be sent as a number, see SetSQLiteStorage.
 func (d Date) Value() (driver.Value, error) {
 	if !d.Valid {
 		return nil, nil
 	}
-	return d.Time.Format(dateFormat), nil
+	if inf, ok := infinityString(d.Time, d.Valid); ok {
+		return inf, nil
+	}
+	if v, ok := sqliteValue(d.Time); ok {
+		return v, nil
+	}
+	return dateValue(d.Time), nil
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // It converts the Date into a JSON string (or null if invalid).
 func (d Date) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(make([]byte, 0, jsonBufSize)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Date to b, as returned by MarshalJSON.
+// With SetProtoJSON enabled, the Date is encoded as a google.type.Date object.
+func (d Date) AppendJSON(b []byte) []byte {
 	if !d.Valid {
-		return []byte("null"), nil
+		return appendNullJSON(b, zeroDateJSON)
+	}
+	if inf, ok := infinityString(d.Time, d.Valid); ok {
+		return appendJSONString(b, inf)
+	}
+	if protoJSON.Load() && !canonicalOutput.Load() {
+		return appendProtoDate(b, d.Time)
+	}
+	if layout := outputLayout(&dateLayout); layout != "" {
+		return appendJSONString(b, d.Time.Format(layout))
 	}
-	str := fmt.Sprintf(`"%s"`, d.Time.Format(dateFormat))
-	return []byte(str), nil
+	b = append(b, '"')
+	b = appendDate(b, d.Time)
+	return append(b, '"')
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // It parses a JSON string into a Date, handling null and empty strings.
+// A google.type.Date object, as produced by protojson, is also accepted.
 func (d *Date) UnmarshalJSON(data []byte) error {
 	str := string(data)
 	if str == "null" || str == `""` {
@@ -86,6 +199,22 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if len(str) > 0 && str[0] == '{' {
+		t, err := parseProtoDate(data)
+		if err != nil {
+			return err
+		}
+		return d.setChecked(t, string(data))
+	}
+
+	if strictJSON.Load() {
+		s, err := unquoteJSONStrict(data, "Date")
+		if err != nil {
+			return err
+		}
+		return d.parseDateString(s)
+	}
+
 	// Remove surrounding quotes if present
 	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
 		str = str[1 : len(str)-1]
@@ -95,14 +224,102 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 }
 
 // IsZero reports whether the Date is invalid or represents the zero time.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid Date is zero.
 func (d Date) IsZero() bool {
-	return !d.Valid || d.Time.IsZero()
+	return isZero(d.Valid, d.Time.IsZero())
 }
 
-// String returns the Date formatted as YYYY-MM-DD, or an empty string if invalid.
+// IsNull reports whether the Date is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (d Date) IsNull() bool {
+	return !d.Valid
+}
+
+// HasValue reports whether the Date is valid (non-NULL). It is the negation of IsNull.
+func (d Date) HasValue() bool {
+	return d.Valid
+}
+
+// MustTime returns the date held by the Date, panicking if it is invalid (NULL).
+// Use it where NULL has already been ruled out, e.g. in tests.
+func (d Date) MustTime() time.Time {
+	if !d.Valid {
+		panic("types: MustTime called on a NULL Date")
+	}
+	return d.Time
+}
+
+// String returns the Date formatted as YYYY-MM-DD, or in the layout set with
+// SetDateFormat, or an empty string if invalid.
+// An infinite Date is returned as "infinity" or "-infinity".
 func (d Date) String() string {
 	if !d.Valid {
 		return ""
 	}
+	if inf, ok := infinityString(d.Time, d.Valid); ok {
+		return inf
+	}
+	if layout := outputLayout(&dateLayout); layout != "" {
+		return d.Time.Format(layout)
+	}
 	return d.Time.Format(dateFormat)
 }
+
+// Set implements the flag.Value interface.
+// It parses a YYYY-MM-DD string into the Date, marking it invalid if the string is empty.
+func (d *Date) Set(s string) error {
+	return d.parseDateString(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (d *Date) Type() string {
+	return "date"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Date is encoded as empty text.
+func (d Date) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the Date as returned by String to b.
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	if !d.Valid {
+		return b, nil
+	}
+	if inf, ok := infinityString(d.Time, d.Valid); ok {
+		return append(b, inf...), nil
+	}
+	if layout := outputLayout(&dateLayout); layout != "" {
+		return d.Time.AppendFormat(b, layout), nil
+	}
+	return appendDate(b, d.Time), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses a YYYY-MM-DD string into the Date, marking it invalid if the text is empty.
+func (d *Date) UnmarshalText(text []byte) error {
+	return d.parseDateString(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Date.
+func (d *Date) UnmarshalParam(param string) error {
+	return d.parseDateString(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the Date as a YYYY-MM-DD string, or nil if invalid.
+func (d Date) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(d.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (d Date) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Date", "Time", d.String(), d.Valid)
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+var benchDate = types.NewDate(time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC))
+
+func BenchmarkDateScan(b *testing.B) {
+	var d types.Date
+	benchScan(b, &d, []byte("2024-12-25"))
+}
+
+func BenchmarkDateScanTime(b *testing.B) {
+	var d types.Date
+	benchScan(b, &d, benchDate.Time)
+}
+
+func BenchmarkDateValue(b *testing.B) {
+	benchValue(b, benchDate)
+}
+
+func BenchmarkDateAppendJSON(b *testing.B) {
+	benchAppendJSON(b, benchDate)
+}
+
+func BenchmarkDateMarshalJSON(b *testing.B) {
+	benchMarshalJSON(b, benchDate)
+}
+
+func BenchmarkDateUnmarshalJSON(b *testing.B) {
+	var d types.Date
+	benchUnmarshalJSON(b, &d, []byte(`"2024-12-25"`))
+}
+
+func TestDateAllocs(t *testing.T) {
+	var d types.Date
+	var bytesSrc, stringSrc, timeSrc any = []byte("2024-12-25"), "2024-12-25", benchDate.Time
+	buf := make([]byte, 0, 64)
+	data := []byte(`"2024-12-25"`)
+
+	assertAllocs(t, "Scan([]byte)", 0, func() { _ = d.Scan(bytesSrc) })
+	assertAllocs(t, "Scan(string)", 0, func() { _ = d.Scan(stringSrc) })
+	assertAllocs(t, "Scan(time.Time)", 0, func() { _ = d.Scan(timeSrc) })
+	assertAllocs(t, "AppendJSON", 0, func() { buf = benchDate.AppendJSON(buf[:0]) })
+	assertAllocs(t, "MarshalJSON", 1, func() { bytesSink, _ = benchDate.MarshalJSON() })
+	assertAllocs(t, "UnmarshalJSON", 0, func() { _ = d.UnmarshalJSON(data) })
+	// The formatted string, and boxing it into a driver.Value.
+	assertAllocs(t, "Value", 2, func() { valueSink, _ = benchDate.Value() })
+}
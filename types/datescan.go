@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// DateScanPolicy selects what Date.Scan does with a value that has a time of
+// day, such as a timestamp column accidentally mapped to a Date. It is selected
+// package-wide with SetDateScanPolicy.
+type DateScanPolicy int32
+
+const (
+	// DiscardTimeOfDay truncates the value to midnight UTC. This is the default.
+	DiscardTimeOfDay DateScanPolicy = iota
+
+	// WarnTimeOfDay truncates the value like DiscardTimeOfDay, but logs a
+	// warning with slog's default logger.
+	WarnTimeOfDay
+
+	// RejectTimeOfDay makes Scan fail with an *ErrInvalidFormat.
+	RejectTimeOfDay
+)
+
+// String returns the name of the date scan policy.
+func (p DateScanPolicy) String() string {
+	switch p {
+	case DiscardTimeOfDay:
+		return "discard"
+	case WarnTimeOfDay:
+		return "warn"
+	case RejectTimeOfDay:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+// errTimeOfDay is wrapped when a value scanned into a Date has a time of day.
+var errTimeOfDay = errors.New("has a time of day")
+
+// scanDay sets the Date to t's calendar date. A t with a time of day, in its
+// own location, is truncated to midnight UTC after applying the policy selected
+// with SetDateScanPolicy. input is the value as received from the driver, for
+// errors and warnings; if empty, t is formatted in its place.
+func (d *Date) scanDay(t time.Time, input string) error {
+	if hour, minute, second := t.Clock(); hour == 0 && minute == 0 && second == 0 && t.Nanosecond() == 0 {
+		year, month, day := t.Date()
+		return d.setChecked(time.Date(year, month, day, 0, 0, 0, 0, time.UTC), input)
+	}
+	switch DateScanPolicy(dateScanPolicy.Load()) {
+	case WarnTimeOfDay:
+		if input == "" {
+			input = t.Format(time.RFC3339Nano)
+		}
+		// Cloned so that input does not escape on the paths that do not log.
+		slog.Warn("types: discarding time of day scanned into Date", "value", strings.Clone(input))
+	case RejectTimeOfDay:
+		if input == "" {
+			input = t.Format(time.RFC3339Nano)
+		}
+		return invalidFormat("Date", input, "a date without time of day", errTimeOfDay)
+	}
+	return d.setChecked(t.Truncate(24*time.Hour), input)
+}
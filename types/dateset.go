@@ -0,0 +1,264 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+)
+
+// DateSet is a nullable, sorted set of distinct Dates, e.g. blackout dates or
+// holidays. It is stored in PostgreSQL as a date[] and encoded in JSON as an
+// array of YYYY-MM-DD strings.
+//
+// The zero DateSet is NULL; Add and NewDateSet yield a valid, possibly empty, set.
+// Methods never modify a set shared with another DateSet value, so DateSets can
+// be copied freely.
+type DateSet struct {
+	dates []Date
+	valid bool
+}
+
+// NewDateSet returns a valid DateSet containing the given dates.
+// Invalid dates are ignored.
+func NewDateSet(dates ...Date) DateSet {
+	s := DateSet{valid: true}
+	s.Add(dates...)
+	return s
+}
+
+// NullDateSet returns a NULL DateSet. It is equivalent to DateSet{}.
+func NullDateSet() DateSet {
+	return DateSet{}
+}
+
+// Valid reports whether the DateSet is non-NULL.
+func (s DateSet) Valid() bool {
+	return s.valid
+}
+
+// Len returns the number of dates in the set.
+func (s DateSet) Len() int {
+	return len(s.dates)
+}
+
+// Dates returns the dates in ascending order. The slice is a copy.
+func (s DateSet) Dates() []Date {
+	return slices.Clone(s.dates)
+}
+
+// Add adds the given dates to the set, marking it valid. Invalid dates are ignored.
+func (s *DateSet) Add(dates ...Date) {
+	s.valid = true
+	if len(dates) == 1 {
+		d := dates[0]
+		if !d.Valid {
+			return
+		}
+		if i, found := slices.BinarySearchFunc(s.dates, d, compareSetDates); !found {
+			// Clipped so that Insert copies rather than writing into a backing
+			// array shared with another DateSet.
+			s.dates = slices.Insert(slices.Clip(s.dates), i, d)
+		}
+		return
+	}
+	// Copied once so that sorting does not write into a backing array shared
+	// with another DateSet.
+	out := make([]Date, len(s.dates), len(s.dates)+len(dates))
+	copy(out, s.dates)
+	for _, d := range dates {
+		if d.Valid {
+			out = append(out, d)
+		}
+	}
+	if len(out) > len(s.dates) {
+		s.dates = sortSetDates(out)
+	}
+}
+
+// sortSetDates sorts dates in place and removes duplicates, returning the
+// shortened slice.
+func sortSetDates(dates []Date) []Date {
+	slices.SortFunc(dates, compareSetDates)
+	return slices.CompactFunc(dates, func(a, b Date) bool { return compareSetDates(a, b) == 0 })
+}
+
+// Contains reports whether d is in the set.
+func (s DateSet) Contains(d Date) bool {
+	if !d.Valid {
+		return false
+	}
+	_, found := slices.BinarySearchFunc(s.dates, d, compareSetDates)
+	return found
+}
+
+// Union returns the dates in s or o. The result is NULL only if both are NULL.
+func (s DateSet) Union(o DateSet) DateSet {
+	out := DateSet{dates: make([]Date, 0, len(s.dates)+len(o.dates)), valid: s.valid || o.valid}
+	i, j := 0, 0
+	for i < len(s.dates) && j < len(o.dates) {
+		switch c := compareSetDates(s.dates[i], o.dates[j]); {
+		case c < 0:
+			out.dates = append(out.dates, s.dates[i])
+			i++
+		case c > 0:
+			out.dates = append(out.dates, o.dates[j])
+			j++
+		default:
+			out.dates = append(out.dates, s.dates[i])
+			i++
+			j++
+		}
+	}
+	out.dates = append(out.dates, s.dates[i:]...)
+	out.dates = append(out.dates, o.dates[j:]...)
+	return out
+}
+
+// Intersect returns the dates in both s and o. The result is NULL if either is NULL.
+func (s DateSet) Intersect(o DateSet) DateSet {
+	if !s.valid || !o.valid {
+		return DateSet{}
+	}
+	out := DateSet{valid: true}
+	i, j := 0, 0
+	for i < len(s.dates) && j < len(o.dates) {
+		switch c := compareSetDates(s.dates[i], o.dates[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			out.dates = append(out.dates, s.dates[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// compareSetDates orders Dates by day, ignoring any time of day.
+func compareSetDates(a, b Date) int {
+	ay, am, ad := a.Time.Date()
+	by, bm, bd := b.Time.Date()
+	if c := ay - by; c != 0 {
+		return c
+	}
+	if c := int(am) - int(bm); c != 0 {
+		return c
+	}
+	return ad - bd
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL date[] in text form, such as {2024-12-25,2024-12-26},
+// handling NULL. NULL elements are ignored.
+func (s *DateSet) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*s = DateSet{}
+		return nil
+	case []byte:
+		return s.parseArray(string(v))
+	case string:
+		return s.parseArray(v)
+	default:
+		return unsupportedScanType("DateSet", value)
+	}
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal of dates.
+func (s *DateSet) parseArray(text string) error {
+	var dates []Date
+	err := scanArrayLiteral(text, func(_ int, elem string, null bool) error {
+		if null {
+			return nil
+		}
+		var d Date
+		if err := d.scanDateString(elem); err != nil {
+			return err
+		}
+		if d.Valid {
+			dates = append(dates, d)
+		}
+		return nil
+	})
+	if err != nil {
+		return invalidFormat("DateSet", text, "date[] literal", err)
+	}
+	*s = DateSet{dates: sortSetDates(dates), valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the set as a PostgreSQL date[] literal, or nil if NULL.
+func (s DateSet) Value() (driver.Value, error) {
+	if !s.valid {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// String returns the set as a PostgreSQL array literal, e.g. {2024-12-25,2024-12-26},
+// or an empty string if NULL.
+func (s DateSet) String() string {
+	if !s.valid {
+		return ""
+	}
+	b := make([]byte, 0, 2+len(s.dates)*11)
+	b = append(b, '{')
+	for i, d := range s.dates {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = append(b, d.String()...)
+	}
+	return string(append(b, '}'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the set as a JSON array of dates, or null if NULL.
+func (s DateSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, 2+len(s.dates)*13)), nil
+}
+
+// AppendJSON appends the JSON encoding of the DateSet to b, as returned by MarshalJSON.
+func (s DateSet) AppendJSON(b []byte) []byte {
+	if !s.valid {
+		return appendNullJSON(b, zeroArrayJSON)
+	}
+	return AppendJSONArray(b, s.dates)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of dates, handling null. Duplicates are removed and
+// null elements ignored.
+func (s *DateSet) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = DateSet{}
+		return nil
+	}
+	var dates []Date
+	if err := json.Unmarshal(data, &dates); err != nil {
+		return invalidFormat("DateSet", string(data), "JSON array of dates", err)
+	}
+	*s = NewDateSet(dates...)
+	return nil
+}
+
+// IsZero reports whether the DateSet is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL DateSet is zero.
+func (s DateSet) IsZero() bool {
+	return isZero(s.valid, len(s.dates) == 0)
+}
+
+// IsNull reports whether the DateSet is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s DateSet) IsNull() bool {
+	return !s.valid
+}
+
+// HasValue reports whether the DateSet is valid (non-NULL). It is the negation of IsNull.
+func (s DateSet) HasValue() bool {
+	return s.valid
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// DateSlice is a nullable slice of Dates, in order and possibly repeating,
+// unlike DateSet. It is stored in PostgreSQL as a date[] and encoded in JSON as
+// an array of YYYY-MM-DD strings. NULL elements are kept as invalid Dates.
+//
+// A NULL DateSlice differs from an empty one. Errors from parsing an element
+// report its index.
+type DateSlice struct {
+	Val   []Date
+	Valid bool
+}
+
+// NewDateSlice returns a valid DateSlice holding dates.
+func NewDateSlice(dates ...Date) DateSlice {
+	if dates == nil {
+		dates = []Date{}
+	}
+	return DateSlice{Val: dates, Valid: true}
+}
+
+// NullDateSlice returns a NULL DateSlice. It is equivalent to DateSlice{}.
+func NullDateSlice() DateSlice {
+	return DateSlice{}
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL date[] in text form, such as {2024-12-25,NULL},
+// handling NULL. Elements are parsed as by Date.Scan.
+func (s *DateSlice) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*s = DateSlice{}
+		return nil
+	case []byte:
+		return s.parseArray(string(v))
+	case string:
+		return s.parseArray(v)
+	default:
+		return unsupportedScanType("DateSlice", value)
+	}
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal of dates.
+func (s *DateSlice) parseArray(text string) error {
+	dates := []Date{}
+	err := scanArrayLiteral(text, func(_ int, elem string, null bool) error {
+		var d Date
+		if !null {
+			if err := d.scanDateString(elem); err != nil {
+				return err
+			}
+		}
+		dates = append(dates, d)
+		return nil
+	})
+	if err != nil {
+		return invalidFormat("DateSlice", text, "date[] literal", err)
+	}
+	*s = DateSlice{Val: dates, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the slice as a PostgreSQL date[] literal, or nil if NULL.
+func (s DateSlice) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// String returns the slice as a PostgreSQL array literal, e.g. {2024-12-25,NULL},
+// or an empty string if NULL.
+func (s DateSlice) String() string {
+	if !s.Valid {
+		return ""
+	}
+	b := make([]byte, 0, 2+len(s.Val)*11)
+	b = append(b, '{')
+	for i, d := range s.Val {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if !d.Valid {
+			b = append(b, "NULL"...)
+			continue
+		}
+		b = appendArrayElem(b, d.String())
+	}
+	return string(append(b, '}'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the slice as a JSON array of dates, or null if NULL.
+func (s DateSlice) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, 2+len(s.Val)*13)), nil
+}
+
+// AppendJSON appends the JSON encoding of the DateSlice to b, as returned by MarshalJSON.
+func (s DateSlice) AppendJSON(b []byte) []byte {
+	if !s.Valid {
+		return appendNullJSON(b, zeroArrayJSON)
+	}
+	return AppendJSONArray(b, s.Val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of dates, handling null. Elements are decoded as by
+// Date.UnmarshalJSON.
+func (s *DateSlice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = DateSlice{}
+		return nil
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return invalidFormat("DateSlice", string(data), "JSON array of dates", err)
+	}
+	dates := make([]Date, len(elems))
+	for i, elem := range elems {
+		if err := dates[i].UnmarshalJSON(elem); err != nil {
+			return invalidFormat("DateSlice", string(data), "JSON array of dates", fmt.Errorf("element %d: %w", i, err))
+		}
+	}
+	*s = DateSlice{Val: dates, Valid: true}
+	return nil
+}
+
+// IsZero reports whether the DateSlice is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL DateSlice is zero.
+func (s DateSlice) IsZero() bool {
+	return isZero(s.Valid, len(s.Val) == 0)
+}
+
+// IsNull reports whether the DateSlice is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s DateSlice) IsNull() bool {
+	return !s.Valid
+}
+
+// HasValue reports whether the DateSlice is valid (non-NULL). It is the negation of IsNull.
+func (s DateSlice) HasValue() bool {
+	return s.Valid
+}
+
+// Equal reports whether s and other hold equal dates in the same order, or are
+// both NULL. An empty slice is not equal to a NULL one.
+func (s DateSlice) Equal(other DateSlice) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+	return slices.EqualFunc(s.Val, other.Val, Date.Equal)
+}
+
+// Validate returns the error of the first date in the slice that does not
+// validate, wrapped with its index.
+func (s DateSlice) Validate() error {
+	for i, d := range s.Val {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
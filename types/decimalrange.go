@@ -0,0 +1,539 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalExponent bounds the exponent accepted in decimals such as 1e3, so
+// that a short input cannot expand into an arbitrarily long string.
+const maxDecimalExponent = 1000
+
+// errInvalidDecimal is wrapped when a range bound is not a decimal number.
+var errInvalidDecimal = errors.New("invalid decimal")
+
+// DecimalRange is a nullable range of decimal numbers, as stored in PostgreSQL
+// numrange columns, e.g. for pricing tiers. It is stored in PostgreSQL's text
+// form, such as [1.5,10), and encoded in JSON as an object, such as
+// {"lower":1.5,"upper":10,"bounds":"[)"}, with null for an unbounded end.
+//
+// The bounds are held as decimal strings, so that no precision is lost. Like
+// PostgreSQL, parsing and NewDecimalRange keep the kind of each bound, but
+// write empty ranges as [0,0), written "empty". Decimals are made canonical,
+// without exponent, leading or trailing zeros, so 1.50 and 15e-1 become 1.5.
+// Other forms may be assembled by hand; Equal, Contains and Overlaps compare
+// ranges by the numbers they contain.
+type DecimalRange struct {
+	Lower, Upper           string
+	LowerBound, UpperBound RangeBound
+	Valid                  bool
+}
+
+// NewDecimalRange creates a new valid DecimalRange from lower, inclusive, to
+// upper, exclusive. The range is empty if upper is not greater than lower.
+// It returns an error if either bound is not a decimal number.
+func NewDecimalRange(lower, upper string) (DecimalRange, error) {
+	r := DecimalRange{Lower: lower, Upper: upper, UpperBound: Exclusive, Valid: true}
+	lo, lok := canonicalDecimal(lower)
+	up, uok := canonicalDecimal(upper)
+	if !lok || !uok {
+		return DecimalRange{}, invalidFormat("DecimalRange", string(r.appendRange(nil)), "range literal", errInvalidDecimal)
+	}
+	if compareDecimal(lo, up) >= 0 {
+		return DecimalRange{Lower: "0", Upper: "0", UpperBound: Exclusive, Valid: true}, nil
+	}
+	r.Lower, r.Upper = lo, up
+	return r, nil
+}
+
+// ParseDecimalRange parses a range in PostgreSQL's text form, such as
+// "[1.5,10]", "(,5)" or "empty", into its canonical form. An empty string
+// yields an invalid DecimalRange.
+func ParseDecimalRange(s string) (DecimalRange, error) {
+	var r DecimalRange
+	err := r.parseRange(s)
+	return r, err
+}
+
+// NullDecimalRange returns an invalid (NULL) DecimalRange. It is equivalent to DecimalRange{}.
+func NullDecimalRange() DecimalRange {
+	return DecimalRange{}
+}
+
+// parseRange parses s into the DecimalRange, marking it invalid if s is empty.
+func (r *DecimalRange) parseRange(s string) error {
+	if s == "" {
+		*r = DecimalRange{}
+		return nil
+	}
+	text := strings.TrimSpace(s)
+	if strings.EqualFold(text, "empty") {
+		*r = DecimalRange{Lower: "0", Upper: "0", UpperBound: Exclusive, Valid: true}
+		return nil
+	}
+	if len(text) < 3 || !strings.ContainsRune("[(", rune(text[0])) || !strings.ContainsRune("])", rune(text[len(text)-1])) {
+		return invalidFormat("DecimalRange", s, "range literal", nil)
+	}
+	lower, upper, ok := strings.Cut(text[1:len(text)-1], ",")
+	if !ok {
+		return invalidFormat("DecimalRange", s, "range literal", errors.New("missing comma"))
+	}
+	out := DecimalRange{Valid: true}
+	if text[0] == '(' {
+		out.LowerBound = Exclusive
+	}
+	if text[len(text)-1] == ')' {
+		out.UpperBound = Exclusive
+	}
+	out.Lower, out.LowerBound = parseDecimalBound(lower, out.LowerBound)
+	out.Upper, out.UpperBound = parseDecimalBound(upper, out.UpperBound)
+	out, err := out.canonical()
+	if err != nil {
+		return invalidFormat("DecimalRange", s, "range literal", err)
+	}
+	*r = out
+	return nil
+}
+
+// parseDecimalBound parses one end of a range literal, which is unbounded if
+// empty. The decimal is checked by canonical.
+func parseDecimalBound(s string, bound RangeBound) (string, RangeBound) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	if s == "" {
+		return "", Unbounded
+	}
+	return s, bound
+}
+
+// canonical returns the range with canonical decimals, as described for
+// DecimalRange. Unbounded ends are cleared, and empty ranges become [0,0).
+func (r DecimalRange) canonical() (DecimalRange, error) {
+	if r.LowerBound > Unbounded || r.UpperBound > Unbounded {
+		return DecimalRange{}, errors.New("unknown bound")
+	}
+	c := r
+	var ok bool
+	if c.LowerBound == Unbounded {
+		c.Lower = ""
+	} else if c.Lower, ok = canonicalDecimal(r.Lower); !ok {
+		return DecimalRange{}, fmt.Errorf("lower bound: %w", errInvalidDecimal)
+	}
+	if c.UpperBound == Unbounded {
+		c.Upper = ""
+	} else if c.Upper, ok = canonicalDecimal(r.Upper); !ok {
+		return DecimalRange{}, fmt.Errorf("upper bound: %w", errInvalidDecimal)
+	}
+	if c.LowerBound != Unbounded && c.UpperBound != Unbounded {
+		switch cmp := compareDecimal(c.Lower, c.Upper); {
+		case cmp > 0:
+			return DecimalRange{}, errRangeOrder
+		case cmp == 0 && (c.LowerBound == Exclusive || c.UpperBound == Exclusive):
+			return DecimalRange{Lower: "0", Upper: "0", UpperBound: Exclusive, Valid: r.Valid}, nil
+		}
+	}
+	return c, nil
+}
+
+// canonicalDecimal returns s, a decimal number with optional sign, fraction
+// and exponent, without exponent, leading or trailing zeros, and negative zero.
+// It reports false if s is not a decimal number.
+func canonicalDecimal(s string) (string, bool) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	mantissa, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e < -maxDecimalExponent || e > maxDecimalExponent {
+			return "", false
+		}
+		mantissa, exp = s[:i], e
+	}
+	intPart, frac, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && frac == "" {
+		return "", false
+	}
+	if _, ok := atoi(intPart); !ok {
+		return "", false
+	}
+	if _, ok := atoi(frac); !ok {
+		return "", false
+	}
+
+	// The number is 0.digits times ten to the power of point.
+	digits := intPart + frac
+	point := len(intPart) + exp
+	trimmed := strings.TrimLeft(digits, "0")
+	point -= len(digits) - len(trimmed)
+	digits = strings.TrimRight(trimmed, "0")
+	if digits == "" {
+		return "0", true
+	}
+
+	var b strings.Builder
+	b.Grow(len(digits) + max(point, -point) + 3)
+	if neg {
+		b.WriteByte('-')
+	}
+	switch {
+	case point <= 0:
+		b.WriteString("0.")
+		b.WriteString(strings.Repeat("0", -point))
+		b.WriteString(digits)
+	case point >= len(digits):
+		b.WriteString(digits)
+		b.WriteString(strings.Repeat("0", point-len(digits)))
+	default:
+		b.WriteString(digits[:point])
+		b.WriteByte('.')
+		b.WriteString(digits[point:])
+	}
+	return b.String(), true
+}
+
+// compareDecimal compares the canonical decimals a and b, as returned by
+// canonicalDecimal, returning -1, 0 or +1.
+func compareDecimal(a, b string) int {
+	aneg, bneg := strings.HasPrefix(a, "-"), strings.HasPrefix(b, "-")
+	if aneg != bneg {
+		if aneg {
+			return -1
+		}
+		return 1
+	}
+	c := compareMagnitude(strings.TrimPrefix(a, "-"), strings.TrimPrefix(b, "-"))
+	if aneg {
+		return -c
+	}
+	return c
+}
+
+// compareMagnitude compares the canonical, non-negative decimals a and b.
+func compareMagnitude(a, b string) int {
+	aint, afrac, _ := strings.Cut(a, ".")
+	bint, bfrac, _ := strings.Cut(b, ".")
+	// Without leading zeros, the longer integer part is the larger one.
+	if c := len(aint) - len(bint); c != 0 {
+		if c < 0 {
+			return -1
+		}
+		return 1
+	}
+	if c := strings.Compare(aint, bint); c != 0 {
+		return c
+	}
+	// Without trailing zeros, fractions compare as strings.
+	return strings.Compare(afrac, bfrac)
+}
+
+// IsEmpty reports whether the DecimalRange is valid but contains no numbers.
+func (r DecimalRange) IsEmpty() bool {
+	c, err := r.canonical()
+	return r.Valid && err == nil && c.isEmpty()
+}
+
+// isEmpty reports whether the canonical range c is empty.
+func (c DecimalRange) isEmpty() bool {
+	return c.LowerBound == Inclusive && c.UpperBound == Exclusive && c.Lower == c.Upper
+}
+
+// decimalBelow reports whether a lower bound lies below an upper bound, that is
+// whether some number is above the one and below the other.
+func decimalBelow(lower string, lowerBound RangeBound, upper string, upperBound RangeBound) bool {
+	if lowerBound == Unbounded || upperBound == Unbounded {
+		return true
+	}
+	c := compareDecimal(lower, upper)
+	return c < 0 || c == 0 && lowerBound == Inclusive && upperBound == Inclusive
+}
+
+// Contains reports whether the DecimalRange is valid and contains v, a
+// decimal number such as "1.5". It reports false if v is not a decimal number.
+func (r DecimalRange) Contains(v string) bool {
+	c, err := r.canonical()
+	d, ok := canonicalDecimal(v)
+	if !r.Valid || err != nil || !ok || c.isEmpty() {
+		return false
+	}
+	return decimalBelow(c.Lower, c.LowerBound, d, Inclusive) && decimalBelow(d, Inclusive, c.Upper, c.UpperBound)
+}
+
+// Overlaps reports whether r and other are valid and have a number in common.
+func (r DecimalRange) Overlaps(other DecimalRange) bool {
+	a, aerr := r.canonical()
+	b, berr := other.canonical()
+	if !r.Valid || !other.Valid || aerr != nil || berr != nil || a.isEmpty() || b.isEmpty() {
+		return false
+	}
+	return decimalBelow(a.Lower, a.LowerBound, b.Upper, b.UpperBound) &&
+		decimalBelow(b.Lower, b.LowerBound, a.Upper, a.UpperBound)
+}
+
+// appendRange appends the canonical text form of the range to b.
+func (r DecimalRange) appendRange(b []byte) []byte {
+	c, err := r.canonical()
+	if err != nil {
+		// Not representable; written as assembled so that the database rejects it.
+		c = r
+	}
+	if c.isEmpty() {
+		return append(b, "empty"...)
+	}
+	if c.LowerBound == Inclusive {
+		b = append(b, '[')
+	} else {
+		b = append(b, '(')
+	}
+	if c.LowerBound != Unbounded {
+		b = append(b, c.Lower...)
+	}
+	b = append(b, ',')
+	if c.UpperBound != Unbounded {
+		b = append(b, c.Upper...)
+	}
+	if c.UpperBound == Inclusive {
+		return append(b, ']')
+	}
+	return append(b, ')')
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL numrange in text form, such as [1.5,10), handling
+// NULL. sql.RawBytes is accepted too.
+func (r *DecimalRange) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*r = DecimalRange{}
+		return nil
+	case string:
+		return r.parseRange(v)
+	case []byte:
+		return r.parseRange(string(v))
+	default:
+		return unsupportedScanType("DecimalRange", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the range in canonical text form, or nil if invalid.
+func (r DecimalRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// decimalRangeJSON is the JSON object form of a DecimalRange.
+type decimalRangeJSON struct {
+	Lower  *json.Number `json:"lower"`
+	Upper  *json.Number `json:"upper"`
+	Bounds string       `json:"bounds"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the range as a JSON object in canonical form, or null if invalid.
+func (r DecimalRange) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(make([]byte, 0, 64)), nil
+}
+
+// AppendJSON appends the JSON encoding of the DecimalRange to b, as returned by
+// MarshalJSON. The bounds are written as JSON numbers with all their digits.
+func (r DecimalRange) AppendJSON(b []byte) []byte {
+	if !r.Valid {
+		return appendNullJSON(b, zeroRangeJSON)
+	}
+	c, err := r.canonical()
+	if err != nil {
+		// Not representable; the bounds are quoted so that the JSON stays valid.
+		c = r
+		c.Lower, c.Upper = strconv.Quote(r.Lower), strconv.Quote(r.Upper)
+	}
+	b = append(b, `{"lower":`...)
+	if c.LowerBound == Unbounded {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, c.Lower...)
+	}
+	b = append(b, `,"upper":`...)
+	if c.UpperBound == Unbounded {
+		b = append(b, "null"...)
+	} else {
+		b = append(b, c.Upper...)
+	}
+	b = append(b, `,"bounds":"`...)
+	if c.LowerBound == Inclusive {
+		b = append(b, '[')
+	} else {
+		b = append(b, '(')
+	}
+	if c.UpperBound == Inclusive {
+		b = append(b, ']')
+	} else {
+		b = append(b, ')')
+	}
+	return append(b, `"}`...)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON object as produced by MarshalJSON, handling null. The
+// bounds may be JSON numbers or strings holding decimals. A missing bounds
+// member means "[)", and a null or missing lower or upper member an unbounded
+// end. A JSON string in PostgreSQL's text form is accepted too.
+func (r *DecimalRange) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = DecimalRange{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return invalidFormat("DecimalRange", string(data), "JSON object", err)
+		}
+		return r.parseRange(s)
+	}
+	var v decimalRangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return invalidFormat("DecimalRange", string(data), "JSON object", err)
+	}
+	if v.Bounds == "" {
+		v.Bounds = "[)"
+	}
+	if len(v.Bounds) != 2 || !strings.ContainsRune("[(", rune(v.Bounds[0])) || !strings.ContainsRune("])", rune(v.Bounds[1])) {
+		return invalidFormat("DecimalRange", string(data), "JSON object", fmt.Errorf("invalid bounds %q", v.Bounds))
+	}
+	out := DecimalRange{Valid: true}
+	switch {
+	case v.Lower == nil:
+		out.LowerBound = Unbounded
+	case v.Bounds[0] == '(':
+		out.Lower, out.LowerBound = v.Lower.String(), Exclusive
+	default:
+		out.Lower = v.Lower.String()
+	}
+	switch {
+	case v.Upper == nil:
+		out.UpperBound = Unbounded
+	case v.Bounds[1] == ')':
+		out.Upper, out.UpperBound = v.Upper.String(), Exclusive
+	default:
+		out.Upper = v.Upper.String()
+	}
+	out, err := out.canonical()
+	if err != nil {
+		return invalidFormat("DecimalRange", string(data), "JSON object", err)
+	}
+	*r = out
+	return nil
+}
+
+// IsZero reports whether the DecimalRange is invalid.
+func (r DecimalRange) IsZero() bool {
+	return !r.Valid
+}
+
+// IsNull reports whether the DecimalRange is invalid (NULL).
+func (r DecimalRange) IsNull() bool {
+	return !r.Valid
+}
+
+// HasValue reports whether the DecimalRange is valid (non-NULL). It is the negation of IsNull.
+func (r DecimalRange) HasValue() bool {
+	return r.Valid
+}
+
+// Equal reports whether r and other contain the same numbers, or are both invalid.
+func (r DecimalRange) Equal(other DecimalRange) bool {
+	if !r.Valid || !other.Valid {
+		return r.Valid == other.Valid
+	}
+	a, aerr := r.canonical()
+	b, berr := other.canonical()
+	if aerr != nil || berr != nil {
+		return r == other
+	}
+	return a == b
+}
+
+// Validate returns an *ErrInvalidFormat if the DecimalRange is valid but has an
+// unknown bound, a bound that is not a decimal number, or a lower bound above
+// its upper bound.
+func (r DecimalRange) Validate() error {
+	if !r.Valid {
+		return nil
+	}
+	if _, err := r.canonical(); err != nil {
+		return invalidFormat("DecimalRange", string(r.appendRange(nil)), "range literal", err)
+	}
+	return nil
+}
+
+// String returns the range in canonical text form, such as [1.5,10) or empty,
+// or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (r DecimalRange) String() string {
+	if !r.Valid {
+		return ""
+	}
+	return string(r.appendRange(make([]byte, 0, 24)))
+}
+
+// Set implements the flag.Value interface.
+// It parses a range literal into the DecimalRange, marking it invalid if the string is empty.
+func (r *DecimalRange) Set(s string) error {
+	return r.parseRange(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (r *DecimalRange) Type() string {
+	return "decimalrange"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid DecimalRange is encoded as empty text.
+func (r DecimalRange) MarshalText() ([]byte, error) {
+	return r.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the range as returned by String to b.
+func (r DecimalRange) AppendText(b []byte) ([]byte, error) {
+	if !r.Valid {
+		return b, nil
+	}
+	return r.appendRange(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the DecimalRange invalid.
+func (r *DecimalRange) UnmarshalText(text []byte) error {
+	return r.parseRange(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a DecimalRange.
+func (r *DecimalRange) UnmarshalParam(param string) error {
+	return r.parseRange(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the range in text form, or nil if invalid.
+func (r DecimalRange) LogValue() slog.Value {
+	if !r.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(r.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (r DecimalRange) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "DecimalRange", "Range", r.String(), r.Valid)
+}
@@ -0,0 +1,253 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func TestParseDecimalRange(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // canonical String of the parsed range
+	}{
+		{"[1.5,10)", "[1.5,10)"},
+		{"(1.50,10.0]", "(1.5,10]"},
+		{"[15e-1,1E2)", "[1.5,100)"},
+		{"[-0.0,+2)", "[0,2)"},
+		{"[.5,5.)", "[0.5,5)"},
+		{"[-000123.4500,0)", "[-123.45,0)"},
+		{"[1e-3,1e3]", "[0.001,1000]"},
+		{` ["1.5" , "2" ) `, "[1.5,2)"},
+		{"(,5)", "(,5)"},
+		{"[,5]", "(,5]"},
+		{"[5,]", "[5,)"},
+		{"(,)", "(,)"},
+		{"[2,2]", "[2,2]"},
+		{"[2,2)", "empty"},
+		{"(2,2]", "empty"},
+		{"(2,2.0)", "empty"},
+		{"empty", "empty"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			r, err := types.ParseDecimalRange(tt.in)
+			if err != nil {
+				t.Fatalf("ParseDecimalRange(%q): %v", tt.in, err)
+			}
+			if r.Valid != (tt.in != "") {
+				t.Errorf("ParseDecimalRange(%q).Valid = %v", tt.in, r.Valid)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("ParseDecimalRange(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimalRangeErrors(t *testing.T) {
+	for _, in := range []string{
+		"1,10",
+		"[1,10",
+		"[1;10)",
+		"[a,10)",
+		"[1,x)",
+		"[1.2.3,4)",
+		"[.,4)",
+		"[-,4)",
+		"[1e,4)",
+		"[1e1001,)",
+		"[0x10,)",
+		"[NaN,)",
+		"[10,1)",
+		"[-1,-2]",
+		"[]",
+	} {
+		t.Run(in, func(t *testing.T) {
+			r, err := types.ParseDecimalRange(in)
+			if err == nil {
+				t.Fatalf("ParseDecimalRange(%q) = %v, want error", in, r)
+			}
+			if !errors.Is(err, &types.ErrInvalidFormat{Type: "DecimalRange"}) {
+				t.Errorf("ParseDecimalRange(%q) error %v is not a DecimalRange ErrInvalidFormat", in, err)
+			}
+		})
+	}
+}
+
+func TestNewDecimalRange(t *testing.T) {
+	tests := []struct {
+		lower, upper string
+		want         string
+	}{
+		{"1.5", "10", "[1.5,10)"},
+		{"10", "1.5", "empty"},
+		{"2.0", "2", "empty"},
+		{"-1e2", "0.10", "[-100,0.1)"},
+	}
+	for _, tt := range tests {
+		r, err := types.NewDecimalRange(tt.lower, tt.upper)
+		if err != nil {
+			t.Fatalf("NewDecimalRange(%q, %q): %v", tt.lower, tt.upper, err)
+		}
+		if got := r.String(); got != tt.want {
+			t.Errorf("NewDecimalRange(%q, %q) = %q, want %q", tt.lower, tt.upper, got, tt.want)
+		}
+	}
+	if _, err := types.NewDecimalRange("one", "2"); err == nil {
+		t.Error(`NewDecimalRange("one", "2") succeeded, want error`)
+	}
+}
+
+func TestDecimalRangeContains(t *testing.T) {
+	tests := []struct {
+		r, v string
+		want bool
+	}{
+		{"[1.5,10)", "1.5", true},
+		{"[1.5,10)", "1.50", true},
+		{"[1.5,10)", "1.4999", false},
+		{"[1.5,10)", "9.999999999999999999999", true},
+		{"[1.5,10)", "10", false},
+		{"(1.5,10]", "1.5", false},
+		{"(1.5,10]", "1e1", true},
+		{"[-2,-1]", "-1.5", true},
+		{"[-2,-1]", "-0.5", false},
+		{"(,0)", "-1e1000", true},
+		{"(,0)", "0", false},
+		{"(,0)", "-0", false},
+		{"[0,)", "-0", true},
+		{"(,)", "123456789012345678901234567890", true},
+		{"[2,2]", "2", true},
+		{"empty", "0", false},
+		{"[1,2)", "abc", false},
+		{"", "0", false},
+	}
+	for _, tt := range tests {
+		r, err := types.ParseDecimalRange(tt.r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := r.Contains(tt.v); got != tt.want {
+			t.Errorf("%q.Contains(%q) = %v, want %v", tt.r, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalRangeOverlaps(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"[1,1.5)", "[1.5,2)", false},
+		{"[1,1.5]", "[1.5,2)", true},
+		{"[1,1.5]", "(1.5,2)", false},
+		{"[1,1.5)", "(1.4999,2)", true},
+		{"[1,10)", "[3,4)", true},
+		{"[-2,-1]", "[-1,0]", true},
+		{"(,5)", "[5,)", false},
+		{"(,5]", "[5,)", true},
+		{"(,)", "[2,2]", true},
+		{"(,)", "(,)", true},
+		{"empty", "(,)", false},
+		{"empty", "empty", false},
+		{"", "(,)", false},
+	}
+	for _, tt := range tests {
+		a, err := types.ParseDecimalRange(tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := types.ParseDecimalRange(tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := a.Overlaps(b); got != tt.want {
+			t.Errorf("%q.Overlaps(%q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := b.Overlaps(a); got != tt.want {
+			t.Errorf("%q.Overlaps(%q) = %v, want %v", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalRangeHandAssembled(t *testing.T) {
+	// Non-canonical ranges compare by the numbers they contain.
+	r := types.DecimalRange{Lower: "1.50", Upper: "1e1", UpperBound: types.Exclusive, Valid: true}
+	want, _ := types.NewDecimalRange("1.5", "10")
+	if !r.Equal(want) {
+		t.Errorf("%v.Equal(%v) = false, want true", r, want)
+	}
+	empty := types.DecimalRange{Lower: "3", Upper: "3.0", LowerBound: types.Exclusive, UpperBound: types.Inclusive, Valid: true}
+	if !empty.IsEmpty() || empty.String() != "empty" {
+		t.Errorf("%v.IsEmpty() = %v, want true", empty, empty.IsEmpty())
+	}
+	bad := types.DecimalRange{Lower: "x", Upper: "1", UpperBound: types.Exclusive, Valid: true}
+	if bad.Validate() == nil || bad.Contains("0") || bad.Overlaps(bad) {
+		t.Errorf("%v is usable, want it rejected", bad)
+	}
+	if out, err := json.Marshal(bad); err != nil || !json.Valid(out) {
+		t.Errorf("Marshal(%v) = %s, %v, want valid JSON", bad, out, err)
+	}
+}
+
+func TestDecimalRangeJSON(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`{"lower":1.50,"upper":10,"bounds":"[]"}`, `{"lower":1.5,"upper":10,"bounds":"[]"}`},
+		{`{"lower":"0.1","upper":"0.3"}`, `{"lower":0.1,"upper":0.3,"bounds":"[)"}`},
+		{`{"lower":12345678901234567890.123456789,"upper":null}`, `{"lower":12345678901234567890.123456789,"upper":null,"bounds":"[)"}`},
+		{`{"bounds":"()"}`, `{"lower":null,"upper":null,"bounds":"()"}`},
+		{`{"lower":3,"upper":3}`, `{"lower":0,"upper":0,"bounds":"[)"}`},
+		{`"(1.5,2]"`, `{"lower":1.5,"upper":2,"bounds":"(]"}`},
+		{`null`, `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var r types.DecimalRange
+			if err := json.Unmarshal([]byte(tt.in), &r); err != nil {
+				t.Fatal(err)
+			}
+			out, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tt.want {
+				t.Errorf("Marshal(Unmarshal(%s)) = %s, want %s", tt.in, out, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{`{"lower":10,"upper":1}`, `{"bounds":"[["}`, `{"lower":"a"}`, `{"lower":true}`, `[1,10]`} {
+		var r types.DecimalRange
+		if err := json.Unmarshal([]byte(in), &r); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want error", in, r)
+		}
+	}
+}
+
+func TestDecimalRangeValueScan(t *testing.T) {
+	r, _ := types.ParseDecimalRange("[1.5,)")
+	v, err := r.Value()
+	if err != nil || v != "[1.5,)" {
+		t.Fatalf("Value() = %v, %v, want [1.5,)", v, err)
+	}
+	var back types.DecimalRange
+	if err := back.Scan([]byte("[1.5,)")); err != nil || !back.Equal(r) {
+		t.Errorf("Scan = %v, %v, want %v", back, err, r)
+	}
+	if err := back.Scan(nil); err != nil || back.Valid {
+		t.Errorf("Scan(nil) = %v, %v, want invalid", back, err)
+	}
+	if err := back.Scan(1.5); !errors.Is(err, &types.ErrUnsupportedScanType{Type: "DecimalRange"}) {
+		t.Errorf("Scan(1.5) error = %v, want ErrUnsupportedScanType", err)
+	}
+	if long := "[" + strings.Repeat("9", 200) + ",)"; back.Scan(long) != nil || back.String() != long {
+		t.Errorf("Scan(%q) = %v, want all digits kept", long, back)
+	}
+}
@@ -0,0 +1,122 @@
+package types
+
+// Dialect identifies the SQL database whose value formats Scan and Value follow.
+// It is selected package-wide with SetDialect.
+//
+// For every type and dialect, and regardless of the value kinds, SQLite storage
+// and formats selected, Scan accepts whatever Value emits for values within the
+// bounds selected with SetBounds, and yields an equal value. Value may pass a
+// string where another type's Value passes a time.Time, and Scan accepts both
+// forms, so a database that echoes either back round-trips.
+// typestest.RunConformance checks this against a real driver.
+type Dialect int32
+
+const (
+	// Generic is the default dialect. Time is sent to the driver as an "HH:MM"
+	// string, Timestamp as a time.Time, and Scan accepts RFC3339 timestamp strings.
+	Generic Dialect = iota
+
+	// Postgres sends Time as "HH:MM:SS" and accepts the text output of the
+	// timestamp and timestamptz types when scanning.
+	Postgres
+
+	// MySQL sends Time as "HH:MM:SS" and accepts DATETIME text when scanning,
+	// e.g. when the driver is used without parseTime=true.
+	MySQL
+
+	// SQLite sends Time as "HH:MM:SS" and Timestamp as a "YYYY-MM-DD HH:MM:SS"
+	// UTC string, the form understood by SQLite's date and time functions.
+	// Scan accepts the same timestamp formats as mattn/go-sqlite3.
+	SQLite
+
+	// SQLServer sends Time as "HH:MM:SS", which is accepted by both TIME and
+	// DATETIME columns, and accepts DATETIME2 and DATETIMEOFFSET text when scanning.
+	SQLServer
+)
+
+// String returns the name of the dialect.
+func (d Dialect) String() string {
+	switch d {
+	case Generic:
+		return "generic"
+	case Postgres:
+		return "postgres"
+	case MySQL:
+		return "mysql"
+	case SQLite:
+		return "sqlite"
+	case SQLServer:
+		return "sqlserver"
+	default:
+		return "unknown"
+	}
+}
+
+// dialectProfile describes the value formats of one Dialect.
+type dialectProfile struct {
+	// timeValueFormat is the layout of Time values passed to the driver.
+	timeValueFormat string
+
+	// timestampValueFormat is the layout of Timestamp values passed to the
+	// driver, or empty to pass a time.Time.
+	timestampValueFormat string
+
+	// timestampLayouts are accepted by Timestamp.Scan in addition to RFC3339.
+	// Layouts without a zone are interpreted as UTC.
+	timestampLayouts []string
+
+	// dateLayouts are accepted by Date.Scan in addition to YYYY-MM-DD.
+	// Any time of day is discarded.
+	dateLayouts []string
+}
+
+// sqliteTimestampLayouts mirrors the formats mattn/go-sqlite3 recognizes.
+var sqliteTimestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+}
+
+var dialectProfiles = [...]dialectProfile{
+	Generic: {
+		timeValueFormat: timeFormat,
+	},
+	Postgres: {
+		timeValueFormat: "15:04:05",
+		timestampLayouts: []string{
+			"2006-01-02 15:04:05.999999999-07",
+			"2006-01-02 15:04:05.999999999-07:00",
+			"2006-01-02 15:04:05.999999999",
+		},
+	},
+	MySQL: {
+		timeValueFormat:  "15:04:05",
+		timestampLayouts: []string{"2006-01-02 15:04:05.999999999"},
+	},
+	SQLite: {
+		timeValueFormat:      "15:04:05",
+		timestampValueFormat: "2006-01-02 15:04:05",
+		timestampLayouts:     sqliteTimestampLayouts,
+		dateLayouts:          sqliteTimestampLayouts,
+	},
+	SQLServer: {
+		timeValueFormat: "15:04:05",
+		timestampLayouts: []string{
+			"2006-01-02 15:04:05.9999999 -07:00",
+			"2006-01-02T15:04:05.9999999",
+			"2006-01-02 15:04:05.9999999",
+		},
+	},
+}
+
+// currentProfile returns the profile of the dialect selected with SetDialect.
+func currentProfile() *dialectProfile {
+	d := Dialect(dialect.Load())
+	if d < 0 || int(d) >= len(dialectProfiles) {
+		d = Generic
+	}
+	return &dialectProfiles[d]
+}
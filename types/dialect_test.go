@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/j0h-dev/simple-types-go/typestest"
+)
+
+// TestValueRoundTrip checks the guarantee documented on Dialect: Scan accepts
+// whatever Value emits, for every type, dialect, value kind and SQLite storage.
+func TestValueRoundTrip(t *testing.T) {
+	day := time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+	instant := time.Date(2024, time.February, 29, 12, 34, 56, 0, time.UTC)
+	dates := []types.Date{
+		{},
+		types.NewDate(day),
+		types.NewDate(time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewDate(time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)),
+		types.DateInfinity(),
+		types.DateNegativeInfinity(),
+	}
+	times := []types.Time{
+		{},
+		types.NewTime(time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewTime(time.Date(1, time.January, 1, 23, 59, 0, 0, time.UTC)),
+	}
+	timestamps := []types.Timestamp{
+		{},
+		types.NewTimestamp(instant),
+		types.NewTimestamp(time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewTimestamp(time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)),
+		types.TimestampInfinity(),
+		types.TimestampNegativeInfinity(),
+	}
+	strs := []types.String{
+		{},
+		types.NewString(""),
+		types.NewString("it's a \"quoted\" string"),
+		types.NewString("ünïcödé ✓ 🙂"),
+	}
+
+	dialects := []types.Dialect{types.Generic, types.Postgres, types.MySQL, types.SQLite, types.SQLServer}
+	kinds := []types.ValueKind{types.DialectValue, types.StringValue, types.TimeValue}
+	storages := []struct {
+		name    string
+		storage types.SQLiteStorage
+	}{
+		{"text", types.SQLiteText},
+		{"unixtime", types.SQLiteUnixTime},
+		{"julianday", types.SQLiteJulianDay},
+	}
+	defer func() {
+		types.SetDialect(types.Generic)
+		setValueKind(types.DialectValue)
+		types.SetSQLiteStorage(types.SQLiteText)
+	}()
+	for _, d := range dialects {
+		for _, k := range kinds {
+			for _, s := range storages {
+				if s.storage != types.SQLiteText && d != types.SQLite {
+					continue
+				}
+				types.SetDialect(d)
+				setValueKind(k)
+				types.SetSQLiteStorage(s.storage)
+				t.Run(d.String()+"/"+k.String()+"/"+s.name, func(t *testing.T) {
+					t.Run("Date", valueRoundTrips(dates...))
+					t.Run("Time", valueRoundTrips(times...))
+					t.Run("Timestamp", valueRoundTrips(timestamps...))
+					t.Run("String", valueRoundTrips(strs...))
+					t.Run("DateSlice", valueRoundTrips(types.DateSlice{}, types.NewDateSlice(dates...)))
+					t.Run("DateSet", valueRoundTrips(types.DateSet{}, types.NewDateSet(), types.NewDateSet(dates...)))
+					t.Run("TimestampSlice", valueRoundTrips(types.TimestampSlice{}, types.NewTimestampSlice(timestamps...)))
+					t.Run("CompactDate", valueRoundTrips(types.CompactDate{}, types.NewCompactDate(day)))
+					t.Run("CompactTime", valueRoundTrips(types.CompactTime{}, types.NewCompactTime(instant)))
+					t.Run("StringSet", valueRoundTrips(types.StringSet{}, types.NewStringSet(), types.NewStringSet("a", "b c", "NULL", "")))
+					t.Run("Tags", valueRoundTrips(types.Tags{}, types.NewTags("go", "sql")))
+					t.Run("Char", valueRoundTrips(types.Char{}, types.NewChar('ü')))
+					t.Run("Color", valueRoundTrips(types.Color{}, must(types.ParseColor("#ff8000"))))
+					t.Run("Bits", valueRoundTrips(types.Bits{}, must(types.ParseBits("10110"))))
+					t.Run("BoolSlice", valueRoundTrips(types.BoolSlice{}, types.NewBoolSlice(true, false)))
+					t.Run("Float64Slice", valueRoundTrips(types.Float64Slice{}, types.NewFloat64Slice(1.5, -2)))
+					t.Run("FilePath", valueRoundTrips(types.FilePath{}, types.NewFilePath("a/b.txt")))
+					t.Run("MIMEType", valueRoundTrips(types.MIMEType{}, must(types.ParseMIMEType("text/plain; charset=utf-8"))))
+					t.Run("Digest", valueRoundTrips(types.Digest{}, must(types.DigestOf("sha256", []byte("x")))))
+					t.Run("KSUID", valueRoundTrips(types.KSUID{}, types.NewKSUIDAt(instant)))
+					t.Run("SnowflakeID", valueRoundTrips(types.SnowflakeID{}, types.NewSnowflakeID(1234567890)))
+					t.Run("Int64Range", valueRoundTrips(types.Int64Range{}, types.NewInt64Range(1, 10)))
+					t.Run("DecimalRange", valueRoundTrips(types.DecimalRange{}, must(types.ParseDecimalRange("(-1.5,10]")), must(types.ParseDecimalRange("empty"))))
+					t.Run("RRule", valueRoundTrips(types.RRule{}, must(types.ParseRRule("FREQ=WEEKLY;COUNT=3"))))
+				})
+			}
+		}
+	}
+}
+
+func setValueKind(k types.ValueKind) {
+	types.SetDateValueKind(k)
+	types.SetTimeValueKind(k)
+	types.SetTimestampValueKind(k)
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func valueRoundTrips[T driver.Valuer, P interface {
+	*T
+	sql.Scanner
+}](values ...T) func(*testing.T) {
+	return func(t *testing.T) {
+		for _, v := range values {
+			typestest.AssertValueRoundTrip[T, P](t, v)
+		}
+	}
+}
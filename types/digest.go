@@ -0,0 +1,271 @@
+package types
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"log/slog"
+	"strings"
+)
+
+// Digest is a nullable checksum such as an artifact digest, consisting of an
+// algorithm and a lowercase hex value. It is stored and encoded in JSON as a
+// string of the form "sha256:abcd…", as used by OCI images.
+//
+// The supported algorithms are md5, sha1, sha224, sha256, sha384 and sha512.
+// Parsing rejects other algorithms and hex values of the wrong length for the
+// algorithm. Empty text yields an invalid Digest.
+type Digest struct {
+	Algorithm string
+	Hex       string
+	Valid     bool
+}
+
+// digestAlgorithms maps the supported algorithm names to their hash constructors.
+var digestAlgorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha224": sha256.New224,
+	"sha256": sha256.New,
+	"sha384": sha512.New384,
+	"sha512": sha512.New,
+}
+
+// ParseDigest parses a digest such as "sha256:abcd…". The algorithm and hex
+// value are accepted in either case and lowercased. An empty string yields an
+// invalid Digest.
+func ParseDigest(s string) (Digest, error) {
+	var d Digest
+	err := d.parseDigest(s)
+	return d, err
+}
+
+// DigestOf returns the Digest of data computed with the named algorithm, e.g. "sha256".
+func DigestOf(algorithm string, data []byte) (Digest, error) {
+	newHash, ok := digestAlgorithms[strings.ToLower(algorithm)]
+	if !ok {
+		return Digest{}, fmt.Errorf("unsupported digest algorithm %q", algorithm)
+	}
+	h := newHash()
+	h.Write(data)
+	return Digest{Algorithm: strings.ToLower(algorithm), Hex: hex.EncodeToString(h.Sum(nil)), Valid: true}, nil
+}
+
+// NullDigest returns an invalid (NULL) Digest. It is equivalent to Digest{}.
+func NullDigest() Digest {
+	return Digest{}
+}
+
+// parseDigest parses s into the Digest, marking it invalid if s is empty.
+func (d *Digest) parseDigest(s string) error {
+	if s == "" {
+		*d = Digest{}
+		return nil
+	}
+	algorithm, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return invalidFormat("Digest", s, "algorithm:hex", errors.New("missing algorithm"))
+	}
+	algorithm, value = strings.ToLower(algorithm), strings.ToLower(value)
+	if err := checkDigest(algorithm, value); err != nil {
+		return invalidFormat("Digest", s, "algorithm:hex", err)
+	}
+	*d = Digest{Algorithm: algorithm, Hex: value, Valid: true}
+	return nil
+}
+
+// checkDigest returns an error unless algorithm is supported and value is a
+// lowercase hex string of the length of its sums.
+func checkDigest(algorithm, value string) error {
+	newHash, ok := digestAlgorithms[algorithm]
+	if !ok {
+		return fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+	if size := newHash().Size() * 2; len(value) != size {
+		return fmt.Errorf("%s digest must have %d hex digits, got %d", algorithm, size, len(value))
+	}
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return errors.New("invalid hex digit")
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the Digest is valid and is the digest of data.
+func (d Digest) Matches(data []byte) bool {
+	if !d.Valid {
+		return false
+	}
+	got, err := DigestOf(d.Algorithm, data)
+	return err == nil && subtle.ConstantTimeCompare([]byte(got.Hex), []byte(d.Hex)) == 1
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses database values into a Digest, supporting NULL, string, and []byte.
+// sql.RawBytes is accepted too.
+func (d *Digest) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*d = Digest{}
+		return nil
+	case string:
+		return d.parseDigest(v)
+	case []byte:
+		return d.parseDigest(string(v))
+	default:
+		return unsupportedScanType("Digest", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the digest as an "algorithm:hex" string, or nil if invalid.
+func (d Digest) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the digest as an "algorithm:hex" JSON string, or null if invalid.
+func (d Digest) MarshalJSON() ([]byte, error) {
+	return d.AppendJSON(make([]byte, 0, len(d.Algorithm)+len(d.Hex)+3)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Digest to b, as returned by MarshalJSON.
+func (d Digest) AppendJSON(b []byte) []byte {
+	if !d.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	b = append(b, '"')
+	b = d.appendDigest(b)
+	return append(b, '"')
+}
+
+// appendDigest appends the Digest as "algorithm:hex" to b.
+func (d Digest) appendDigest(b []byte) []byte {
+	b = append(b, d.Algorithm...)
+	b = append(b, ':')
+	return append(b, d.Hex...)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a JSON string into the Digest, handling null and empty strings.
+func (d *Digest) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Digest{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("Digest", string(data), "JSON string", err)
+	}
+	return d.parseDigest(s)
+}
+
+// IsZero reports whether the Digest is invalid.
+func (d Digest) IsZero() bool {
+	return !d.Valid
+}
+
+// IsNull reports whether the Digest is invalid (NULL).
+func (d Digest) IsNull() bool {
+	return !d.Valid
+}
+
+// HasValue reports whether the Digest is valid (non-NULL). It is the negation of IsNull.
+func (d Digest) HasValue() bool {
+	return d.Valid
+}
+
+// Equal reports whether d and other have the same algorithm and value, or are both invalid.
+func (d Digest) Equal(other Digest) bool {
+	if !d.Valid || !other.Valid {
+		return d.Valid == other.Valid
+	}
+	return d.Algorithm == other.Algorithm && d.Hex == other.Hex
+}
+
+// Validate returns an *ErrInvalidFormat if the Digest is valid but its
+// algorithm is unsupported or its value is not lowercase hex of the right length.
+func (d Digest) Validate() error {
+	if !d.Valid {
+		return nil
+	}
+	if err := checkDigest(d.Algorithm, d.Hex); err != nil {
+		return invalidFormat("Digest", d.String(), "algorithm:hex", err)
+	}
+	return nil
+}
+
+// String returns the digest as "algorithm:hex", or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (d Digest) String() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Algorithm + ":" + d.Hex
+}
+
+// Set implements the flag.Value interface.
+// It parses a digest into the Digest, marking it invalid if the string is empty.
+func (d *Digest) Set(s string) error {
+	return d.parseDigest(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (d *Digest) Type() string {
+	return "digest"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Digest is encoded as empty text.
+func (d Digest) MarshalText() ([]byte, error) {
+	return d.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the Digest as returned by String to b.
+func (d Digest) AppendText(b []byte) ([]byte, error) {
+	if !d.Valid {
+		return b, nil
+	}
+	return d.appendDigest(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the Digest invalid.
+func (d *Digest) UnmarshalText(text []byte) error {
+	return d.parseDigest(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Digest.
+func (d *Digest) UnmarshalParam(param string) error {
+	return d.parseDigest(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the Digest as an "algorithm:hex" string, or nil if invalid.
+func (d Digest) LogValue() slog.Value {
+	if !d.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(d.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (d Digest) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Digest", "Digest", d.String(), d.Valid)
+}
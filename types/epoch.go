@@ -0,0 +1,77 @@
+package types
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// EpochUnit selects how Timestamp.UnmarshalJSON interprets bare JSON numbers.
+type EpochUnit int32
+
+const (
+	// EpochAuto treats numbers with an absolute value of at least 1e11 as
+	// milliseconds and smaller ones as seconds. 1e11 seconds lies in the year
+	// 5138, while 1e11 milliseconds is in 1973, so current timestamps in either
+	// unit are told apart reliably.
+	EpochAuto EpochUnit = iota
+
+	// EpochSeconds treats numbers as Unix seconds.
+	EpochSeconds
+
+	// EpochMilliseconds treats numbers as Unix milliseconds.
+	EpochMilliseconds
+)
+
+// epochAutoThreshold is the magnitude from which EpochAuto assumes milliseconds.
+const epochAutoThreshold = 1e11
+
+// String returns the name of the epoch unit.
+func (u EpochUnit) String() string {
+	switch u {
+	case EpochAuto:
+		return "auto"
+	case EpochSeconds:
+		return "seconds"
+	case EpochMilliseconds:
+		return "milliseconds"
+	default:
+		return "unknown"
+	}
+}
+
+// isJSONNumber reports whether data starts like a JSON number.
+func isJSONNumber(data []byte) bool {
+	return len(data) > 0 && (data[0] == '-' || data[0] >= '0' && data[0] <= '9')
+}
+
+// parseEpochJSON parses a JSON number as a Unix time in the unit selected with
+// SetTimestampEpochUnit. Fractional values are accepted and truncated to the second.
+func parseEpochJSON(s string) (time.Time, error) {
+	millis := func(abs float64) bool {
+		switch EpochUnit(timestampEpochUnit.Load()) {
+		case EpochMilliseconds:
+			return true
+		case EpochAuto:
+			return abs >= epochAutoThreshold
+		default:
+			return false
+		}
+	}
+
+	// Integers are converted exactly, avoiding float rounding of large values.
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if millis(math.Abs(float64(i))) {
+			return time.UnixMilli(i).UTC().Truncate(time.Second), nil
+		}
+		return time.Unix(i, 0).UTC(), nil
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(n, 0) {
+		return time.Time{}, invalidFormat("Timestamp", s, "Unix epoch number", nil)
+	}
+	if millis(math.Abs(n)) {
+		n /= 1000
+	}
+	return time.Unix(int64(math.Floor(n)), 0).UTC(), nil
+}
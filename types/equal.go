@@ -0,0 +1,83 @@
+package types
+
+import "slices"
+
+// Equal reports whether a and b are equal according to their Equal method.
+// Unlike == and reflect.DeepEqual, which compare the internals of time.Time,
+// such as the location, Equal compares values the way the database stores them.
+// Two invalid (NULL) values are equal, and an invalid value never equals a valid one.
+//
+// The Equal methods are also picked up by github.com/google/go-cmp.
+func Equal[T interface{ Equal(T) bool }](a, b T) bool {
+	return a.Equal(b)
+}
+
+// Equal reports whether d and other are the same calendar date, or both invalid.
+func (d Date) Equal(other Date) bool {
+	if !d.Valid || !other.Valid {
+		return d.Valid == other.Valid
+	}
+	return compareSetDates(d, other) == 0
+}
+
+// Equal reports whether t and other are the same time of day (HH:MM), or both invalid.
+func (t Time) Equal(other Time) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	th, tm, _ := t.Time.Clock()
+	oh, om, _ := other.Time.Clock()
+	return th == oh && tm == om
+}
+
+// Equal reports whether t and other are the same instant, regardless of
+// location, or both invalid.
+func (t Timestamp) Equal(other Timestamp) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return t.Time.Equal(other.Time)
+}
+
+// Equal reports whether s and other hold the same string, or are both invalid.
+func (s String) Equal(other String) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+	return s.Val == other.Val
+}
+
+// Equal reports whether s and other contain the same dates, or are both NULL.
+// An empty set is not equal to a NULL one.
+func (s DateSet) Equal(other DateSet) bool {
+	if !s.valid || !other.valid {
+		return s.valid == other.valid
+	}
+	return slices.EqualFunc(s.dates, other.dates, Date.Equal)
+}
+
+// Equal reports whether r and other have the same canonical form, as returned
+// by String, or are both invalid.
+func (r RRule) Equal(other RRule) bool {
+	if !r.Valid || !other.Valid {
+		return r.Valid == other.Valid
+	}
+	return r.String() == other.String()
+}
+
+// Equal reports whether c and other are the same date, or both invalid.
+// It is equivalent to ==.
+func (c CompactDate) Equal(other CompactDate) bool {
+	return c == other
+}
+
+// Equal reports whether c and other are the same time of day, or both invalid.
+// It is equivalent to ==.
+func (c CompactTime) Equal(other CompactTime) bool {
+	return c == other
+}
+
+// Equal reports whether the wrapped values are equal.
+func (z ZeroJSON[T]) Equal(other ZeroJSON[T]) bool {
+	return any(z.Val).(interface{ Equal(T) bool }).Equal(other.Val)
+}
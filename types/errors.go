@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFormat is returned when input cannot be parsed into one of the
+// types, e.g. by Scan, UnmarshalJSON or UnmarshalText. Use errors.As to inspect
+// it, or errors.Is with an ErrInvalidFormat whose Type is empty (any type) or
+// set to the type of interest:
+//
+//	var fe *types.ErrInvalidFormat
+//	if errors.As(err, &fe) {
+//		return badRequest(fe.Type, fe.Input, fe.Expected)
+//	}
+//	if errors.Is(err, &types.ErrInvalidFormat{Type: "Date"}) { ... }
+type ErrInvalidFormat struct {
+	// Type is the name of the type being parsed, e.g. "Date" or "Timestamp".
+	Type string
+
+	// Input is the rejected input.
+	Input string
+
+	// Expected describes the accepted format, e.g. "YYYY-MM-DD".
+	Expected string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// Error returns a message of the form "invalid date format, expected YYYY-MM-DD: ...".
+func (e *ErrInvalidFormat) Error() string {
+	msg := "invalid " + strings.ToLower(e.Type) + " format"
+	if e.Expected != "" {
+		msg += ", expected " + e.Expected
+	}
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg + ": " + strconv.Quote(e.Input)
+}
+
+// Unwrap returns the underlying error.
+func (e *ErrInvalidFormat) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is an *ErrInvalidFormat for the same Type, or for
+// any type if its Type is empty.
+func (e *ErrInvalidFormat) Is(target error) bool {
+	t, ok := target.(*ErrInvalidFormat)
+	return ok && (t.Type == "" || t.Type == e.Type)
+}
+
+// invalidFormat returns an *ErrInvalidFormat. The input is cloned so that it
+// does not escape, keeping the successful parse paths allocation-free.
+func invalidFormat(typ, input, expected string, err error) error {
+	return &ErrInvalidFormat{Type: typ, Input: strings.Clone(input), Expected: expected, Err: err}
+}
+
+// ErrUnsupportedScanType is returned by Scan when the driver passes a value of
+// a Go type that cannot be converted. errors.Is matches it like ErrInvalidFormat.
+type ErrUnsupportedScanType struct {
+	// Type is the name of the type being scanned into, e.g. "Date".
+	Type string
+
+	// Src is the value passed to Scan.
+	Src any
+}
+
+// Error returns a message of the form "cannot scan int64 into Time".
+func (e *ErrUnsupportedScanType) Error() string {
+	return fmt.Sprintf("cannot scan %T into %s", e.Src, e.Type)
+}
+
+// Is reports whether target is an *ErrUnsupportedScanType for the same Type, or for
+// any type if its Type is empty.
+func (e *ErrUnsupportedScanType) Is(target error) bool {
+	t, ok := target.(*ErrUnsupportedScanType)
+	return ok && (t.Type == "" || t.Type == e.Type)
+}
+
+// unsupportedScanType returns an *ErrUnsupportedScanType.
+func unsupportedScanType(typ string, src any) error {
+	return &ErrUnsupportedScanType{Type: typ, Src: src}
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// FilePath is a nullable file system path, e.g. for file registry tables. It
+// is stored and encoded in JSON as a string. Paths are cleaned with
+// filepath.Clean when constructed or parsed, so equal paths have equal text
+// on the platform the program runs on.
+//
+// Empty text yields an invalid FilePath. ValidateStruct checks the optional
+// constraints given in the `types` tag:
+//
+//	type Upload struct {
+//		Path types.FilePath `json:"path" types:"notnull;abs;ext=.png,.jpg"`
+//	}
+type FilePath struct {
+	Val   string
+	Valid bool
+}
+
+// NewFilePath creates a new valid FilePath from p, cleaned with filepath.Clean.
+// An empty p yields ".", like filepath.Clean.
+func NewFilePath(p string) FilePath {
+	return FilePath{Val: filepath.Clean(p), Valid: true}
+}
+
+// NullFilePath returns an invalid (NULL) FilePath. It is equivalent to FilePath{}.
+func NullFilePath() FilePath {
+	return FilePath{}
+}
+
+// Scan implements the sql.Scanner interface.
+// It converts database values into a FilePath, supporting NULL, string, and []byte.
+// sql.RawBytes is accepted too.
+func (p *FilePath) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*p = FilePath{}
+		return nil
+	case string:
+		return p.parseFilePath(v)
+	case []byte:
+		return p.parseFilePath(string(v))
+	default:
+		return unsupportedScanType("FilePath", value)
+	}
+}
+
+// parseFilePath cleans s into the FilePath, marking it invalid if s is empty.
+func (p *FilePath) parseFilePath(s string) error {
+	if s == "" {
+		*p = FilePath{}
+		return nil
+	}
+	*p = NewFilePath(s)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the path as a string, or nil if invalid.
+func (p FilePath) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	return p.Val, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the path as a JSON string, or null if invalid.
+func (p FilePath) MarshalJSON() ([]byte, error) {
+	return p.AppendJSON(make([]byte, 0, len(p.Val)+2)), nil
+}
+
+// AppendJSON appends the JSON encoding of the FilePath to b, as returned by MarshalJSON.
+func (p FilePath) AppendJSON(b []byte) []byte {
+	if !p.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	return appendJSONString(b, p.Val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON string into the FilePath, handling null and empty strings.
+func (p *FilePath) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*p = FilePath{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("FilePath", string(data), "JSON string", err)
+	}
+	return p.parseFilePath(s)
+}
+
+// IsZero reports whether the FilePath is invalid or empty.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid FilePath is zero.
+func (p FilePath) IsZero() bool {
+	return isZero(p.Valid, p.Val == "")
+}
+
+// IsNull reports whether the FilePath is invalid (NULL).
+func (p FilePath) IsNull() bool {
+	return !p.Valid
+}
+
+// HasValue reports whether the FilePath is valid (non-NULL). It is the negation of IsNull.
+func (p FilePath) HasValue() bool {
+	return p.Valid
+}
+
+// Equal reports whether p and other hold the same path, or are both invalid.
+func (p FilePath) Equal(other FilePath) bool {
+	if !p.Valid || !other.Valid {
+		return p.Valid == other.Valid
+	}
+	return p.Val == other.Val
+}
+
+// IsAbs reports whether the FilePath is valid and absolute, as filepath.IsAbs.
+func (p FilePath) IsAbs() bool {
+	return p.Valid && filepath.IsAbs(p.Val)
+}
+
+// Ext returns the file name extension, as filepath.Ext, or "" if invalid.
+func (p FilePath) Ext() string {
+	if !p.Valid {
+		return ""
+	}
+	return filepath.Ext(p.Val)
+}
+
+// HasExt reports whether the FilePath is valid and its extension is one of
+// exts, such as ".png", compared case-insensitively.
+func (p FilePath) HasExt(exts ...string) bool {
+	ext := p.Ext()
+	for _, e := range exts {
+		if ext != "" && strings.EqualFold(ext, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an *ErrInvalidFormat if the FilePath is valid but contains
+// a NUL byte, which no file system accepts.
+func (p FilePath) Validate() error {
+	if p.Valid && strings.IndexByte(p.Val, 0) >= 0 {
+		return invalidFormat("FilePath", p.Val, "file path", errors.New("contains a NUL byte"))
+	}
+	return nil
+}
+
+// validateTag checks the abs and ext options of the `types` tag for ValidateStruct.
+func (p FilePath) validateTag(tag reflect.StructTag) error {
+	if !p.Valid {
+		return nil
+	}
+	if _, abs := typesTagOption(tag, "abs"); abs && !p.IsAbs() {
+		return invalidFormat("FilePath", p.Val, "absolute path", errors.New("path is relative"))
+	}
+	if exts, ok := typesTagOption(tag, "ext"); ok && !p.HasExt(strings.Split(exts, ",")...) {
+		return invalidFormat("FilePath", p.Val, "extension "+exts, fmt.Errorf("extension %q not allowed", p.Ext()))
+	}
+	return nil
+}
+
+// String returns the path, or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (p FilePath) String() string {
+	if !p.Valid {
+		return ""
+	}
+	return p.Val
+}
+
+// Set implements the flag.Value interface.
+// It cleans the path into the FilePath, marking it invalid if the string is empty.
+func (p *FilePath) Set(s string) error {
+	return p.parseFilePath(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (p *FilePath) Type() string {
+	return "path"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid FilePath is encoded as empty text.
+func (p FilePath) MarshalText() ([]byte, error) {
+	return p.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the path to b, or nothing if invalid.
+func (p FilePath) AppendText(b []byte) ([]byte, error) {
+	if !p.Valid {
+		return b, nil
+	}
+	return append(b, p.Val...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the FilePath invalid.
+func (p *FilePath) UnmarshalText(text []byte) error {
+	return p.parseFilePath(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a FilePath.
+func (p *FilePath) UnmarshalParam(param string) error {
+	return p.parseFilePath(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the path, or nil if invalid.
+func (p FilePath) LogValue() slog.Value {
+	if !p.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(p.Val)
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (p FilePath) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "FilePath", "Val", p.String(), p.Valid)
+}
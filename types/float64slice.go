@@ -0,0 +1,205 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Float64Slice is a nullable slice of float64, e.g. for embedding vectors or
+// measurement series. It is stored in PostgreSQL as a double precision[] and
+// encoded in JSON as an array of numbers.
+//
+// A NULL Float64Slice differs from an empty one. NULL elements, which a
+// []float64 cannot hold, are rejected when scanning and decoding.
+type Float64Slice struct {
+	Val   []float64
+	Valid bool
+}
+
+// NewFloat64Slice returns a valid Float64Slice holding vals.
+func NewFloat64Slice(vals ...float64) Float64Slice {
+	if vals == nil {
+		vals = []float64{}
+	}
+	return Float64Slice{Val: vals, Valid: true}
+}
+
+// NullFloat64Slice returns a NULL Float64Slice. It is equivalent to Float64Slice{}.
+func NullFloat64Slice() Float64Slice {
+	return Float64Slice{}
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL double precision[] in text form, such as {1,2.5,NaN},
+// handling NULL.
+func (s *Float64Slice) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*s = Float64Slice{}
+		return nil
+	case []byte:
+		return s.parseArray(string(v))
+	case string:
+		return s.parseArray(v)
+	default:
+		return unsupportedScanType("Float64Slice", value)
+	}
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal of numbers.
+func (s *Float64Slice) parseArray(text string) error {
+	vals := []float64{}
+	err := scanArrayLiteral(text, func(_ int, elem string, null bool) error {
+		if null {
+			return errNullElement
+		}
+		f, err := strconv.ParseFloat(elem, 64)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, f)
+		return nil
+	})
+	if err != nil {
+		return invalidFormat("Float64Slice", text, "double precision[] literal", err)
+	}
+	*s = Float64Slice{Val: vals, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the slice as a PostgreSQL double precision[] literal, or nil if NULL.
+func (s Float64Slice) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// String returns the slice as a PostgreSQL array literal, e.g. {1,2.5,-Infinity},
+// or an empty string if NULL.
+func (s Float64Slice) String() string {
+	if !s.Valid {
+		return ""
+	}
+	b := make([]byte, 0, 2+len(s.Val)*8)
+	b = append(b, '{')
+	for i, f := range s.Val {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		switch {
+		case math.IsInf(f, 1):
+			b = append(b, "Infinity"...)
+		case math.IsInf(f, -1):
+			b = append(b, "-Infinity"...)
+		default:
+			b = strconv.AppendFloat(b, f, 'g', -1, 64)
+		}
+	}
+	return string(append(b, '}'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the slice as a JSON array of numbers, or null if NULL.
+// NaN and infinite elements cannot be encoded in JSON and yield an error.
+func (s Float64Slice) MarshalJSON() ([]byte, error) {
+	for _, f := range s.Val {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, &json.UnsupportedValueError{Str: strconv.FormatFloat(f, 'g', -1, 64)}
+		}
+	}
+	return s.AppendJSON(make([]byte, 0, 2+len(s.Val)*8)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Float64Slice to b, as returned by
+// MarshalJSON. NaN and infinite elements are encoded as null.
+func (s Float64Slice) AppendJSON(b []byte) []byte {
+	if !s.Valid {
+		return appendNullJSON(b, zeroArrayJSON)
+	}
+	b = append(b, '[')
+	for i, f := range s.Val {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONFloat(b, f)
+	}
+	return append(b, ']')
+}
+
+// appendJSONFloat appends f to b as a JSON number, formatted like encoding/json
+// does, or null if f is NaN or infinite.
+func appendJSONFloat(b []byte, f float64) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(b, "null"...)
+	}
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		b = strconv.AppendFloat(b, f, 'e', -1, 64)
+		// Clean up e-09 to e-9, as encoding/json does.
+		if n := len(b); n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+		return b
+	}
+	return strconv.AppendFloat(b, f, 'f', -1, 64)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of numbers, handling null.
+func (s *Float64Slice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = Float64Slice{}
+		return nil
+	}
+	var elems []*float64
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return invalidFormat("Float64Slice", string(data), "JSON array of numbers", err)
+	}
+	vals := make([]float64, len(elems))
+	for i, f := range elems {
+		if f == nil {
+			return invalidFormat("Float64Slice", string(data), "JSON array of numbers", fmt.Errorf("element %d: %w", i, errNullElement))
+		}
+		vals[i] = *f
+	}
+	*s = Float64Slice{Val: vals, Valid: true}
+	return nil
+}
+
+// IsZero reports whether the Float64Slice is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL Float64Slice is zero.
+func (s Float64Slice) IsZero() bool {
+	return isZero(s.Valid, len(s.Val) == 0)
+}
+
+// IsNull reports whether the Float64Slice is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s Float64Slice) IsNull() bool {
+	return !s.Valid
+}
+
+// HasValue reports whether the Float64Slice is valid (non-NULL). It is the negation of IsNull.
+func (s Float64Slice) HasValue() bool {
+	return s.Valid
+}
+
+// Equal reports whether s and other hold the same numbers, or are both NULL.
+// An empty slice is not equal to a NULL one, and NaN elements are never equal.
+func (s Float64Slice) Equal(other Float64Slice) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+	return slices.Equal(s.Val, other.Val)
+}
+
+// Validate always returns nil, as every float64 can be stored in a double precision[].
+func (s Float64Slice) Validate() error {
+	return nil
+}
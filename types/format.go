@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+// nullText is printed in place of the value when a type is invalid.
+const nullText = "<null>"
+
+// formatNullable implements the shared fmt.Formatter behavior for all types.
+// field is the name of the struct field holding the value, used by %+v.
+//
+//	%v, %s  the value, or <null> if invalid
+//	%q      the value as a double-quoted string, or <null> if invalid
+//	%+v     the value together with its validity, e.g. {Time:2024-01-01 Valid:true}
+func formatNullable(f fmt.State, verb rune, typeName, field, value string, valid bool) {
+	if !valid {
+		value = nullText
+	}
+
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "{%s:%s Valid:%t}", field, value, valid)
+			return
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), value)
+	case 's':
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), value)
+	case 'q':
+		if !valid {
+			fmt.Fprintf(f, fmt.FormatString(f, 's'), value)
+			return
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 'q'), value)
+	default:
+		fmt.Fprintf(f, "%%!%c(types.%s=%s)", verb, typeName, value)
+	}
+}
@@ -0,0 +1,280 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+)
+
+// MarshalFormatted returns the JSON encoding of the struct v, like json.Marshal,
+// except that Date, Time and Timestamp fields tagged with a layout are encoded
+// in that layout instead of the package-wide format:
+//
+//	type Payload struct {
+//		Due     types.Date      `json:"due" types:"format=02.01.2006"`
+//		Created types.Timestamp `json:"created" types:"format=2006-01-02 15:04"`
+//	}
+//
+// Layouts are time.Parse layouts; Timestamps are formatted in UTC. Invalid
+// values are encoded as null, or omitted with omitempty. The tag applies to the
+// fields of v and of structs embedded in it, not to nested struct fields, and
+// may be combined with other options, such as "notnull;format=02.01.2006".
+// Fields promoted through a nil embedded pointer are encoded as zero values.
+func MarshalFormatted(v any) ([]byte, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot marshal %T with formats, expected a struct", v)
+	}
+	m := formattedMirrorOf(rv.Type())
+	out := reflect.New(m.typ).Elem()
+	for i, f := range m.fields {
+		field, err := rv.FieldByIndexErr(f.index)
+		if err != nil {
+			// Promoted through a nil embedded pointer: unlike json.Marshal, which
+			// omits such fields, they are encoded as their zero value.
+			continue
+		}
+		if f.layout == "" {
+			out.Field(i).Set(field)
+			continue
+		}
+		raw, err := formatField(field.Interface(), f.layout)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.name, err)
+		}
+		out.Field(i).Set(reflect.ValueOf(raw))
+	}
+	return json.Marshal(out.Interface())
+}
+
+// UnmarshalFormatted parses the JSON-encoded data into the struct pointed to by
+// v, like json.Unmarshal, except that Date, Time and Timestamp fields tagged
+// with a layout, as described for MarshalFormatted, are parsed in that layout.
+// Null and empty strings yield invalid values.
+func UnmarshalFormatted(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot unmarshal into %T with formats, expected a pointer to a struct", v)
+	}
+	rv = rv.Elem()
+	m := formattedMirrorOf(rv.Type())
+
+	// Seed the mirror with the current values, so fields absent from data are
+	// left unchanged as json.Unmarshal does.
+	in := reflect.New(m.typ)
+	for i, f := range m.fields {
+		if field, err := rv.FieldByIndexErr(f.index); err == nil && f.layout == "" {
+			in.Elem().Field(i).Set(field)
+		}
+	}
+	if err := json.Unmarshal(data, in.Interface()); err != nil {
+		return err
+	}
+
+	for i, f := range m.fields {
+		src := in.Elem().Field(i)
+		if f.layout != "" {
+			raw := src.Interface().(json.RawMessage)
+			if raw == nil {
+				continue
+			}
+			field, err := fieldByIndexAlloc(rv, f.index)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", f.name, err)
+			}
+			if err := parseField(field.Addr().Interface(), raw, f.layout); err != nil {
+				return fmt.Errorf("field %s: %w", f.name, err)
+			}
+			continue
+		}
+		if _, err := rv.FieldByIndexErr(f.index); err != nil && src.IsZero() {
+			// Not in data; leave the nil embedded pointer alone.
+			continue
+		}
+		field, err := fieldByIndexAlloc(rv, f.index)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+		field.Set(src)
+	}
+	return nil
+}
+
+// fieldByIndexAlloc returns the field at index, allocating nil embedded pointers on the way.
+func fieldByIndexAlloc(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct %s", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+// formattedMirror is a struct type with one field per JSON field of a user's
+// struct. Fields with a layout are json.RawMessage, holding the pre-formatted
+// value; the others have their original type and tag.
+type formattedMirror struct {
+	typ    reflect.Type
+	fields []formattedField
+}
+
+type formattedField struct {
+	name   string
+	index  []int
+	layout string
+}
+
+var formattedMirrors sync.Map // reflect.Type -> *formattedMirror
+
+var (
+	dateType      = reflect.TypeFor[Date]()
+	timeType      = reflect.TypeFor[Time]()
+	timestampType = reflect.TypeFor[Timestamp]()
+	rawType       = reflect.TypeFor[json.RawMessage]()
+)
+
+// formattedMirrorOf returns the mirror of the struct type t, building it on first use.
+func formattedMirrorOf(t reflect.Type) *formattedMirror {
+	if m, ok := formattedMirrors.Load(t); ok {
+		return m.(*formattedMirror)
+	}
+	var m formattedMirror
+	var structFields []reflect.StructField
+	for _, f := range fields.Of(t, "json") {
+		sf := t.FieldByIndex(f.Index)
+		layout, _ := typesTagOption(sf.Tag, "format")
+		switch sf.Type {
+		case dateType, timeType, timestampType:
+		default:
+			layout = ""
+		}
+
+		// The original options, such as ",string", are kept for unformatted fields.
+		_, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		typ := sf.Type
+		if layout != "" {
+			typ = rawType
+			opts = ""
+			if f.OmitEmpty {
+				opts = "omitempty"
+			}
+		}
+		tag := f.Name
+		if opts != "" {
+			tag += "," + opts
+		}
+		structFields = append(structFields, reflect.StructField{
+			Name: "F" + strconv.Itoa(len(structFields)),
+			Type: typ,
+			Tag:  reflect.StructTag(`json:` + strconv.Quote(tag)),
+		})
+		m.fields = append(m.fields, formattedField{name: sf.Name, index: f.Index, layout: layout})
+	}
+	m.typ = reflect.StructOf(structFields)
+	actual, _ := formattedMirrors.LoadOrStore(t, &m)
+	return actual.(*formattedMirror)
+}
+
+// formatField encodes a Date, Time or Timestamp as a JSON string in layout.
+// Invalid values yield nil, which encodes as null and is dropped by omitempty.
+func formatField(v any, layout string) (json.RawMessage, error) {
+	var t time.Time
+	switch v := v.(type) {
+	case Date:
+		if !v.Valid {
+			return nil, nil
+		}
+		if inf, ok := infinityString(v.Time, v.Valid); ok {
+			return appendJSONString(nil, inf), nil
+		}
+		t = v.Time
+	case Time:
+		if !v.Valid {
+			return nil, nil
+		}
+		t = v.Time
+	case Timestamp:
+		if !v.Valid {
+			return nil, nil
+		}
+		if inf, ok := infinityString(v.Time, v.Valid); ok {
+			return appendJSONString(nil, inf), nil
+		}
+		t = v.Time.UTC()
+	default:
+		return nil, fmt.Errorf("cannot format %T", v)
+	}
+	return appendJSONString(nil, t.Format(layout)), nil
+}
+
+// parseField parses a JSON string in layout into the Date, Time or Timestamp pointed to by dst.
+func parseField(dst any, raw json.RawMessage, layout string) error {
+	var s string
+	if string(raw) != "null" {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+	}
+	inf, infinite := parseInfinity(s)
+
+	switch d := dst.(type) {
+	case *Date:
+		switch {
+		case s == "":
+			*d = Date{}
+		case infinite:
+			*d = Date{Time: inf, Valid: true}
+		default:
+			t, err := time.ParseInLocation(layout, s, time.UTC)
+			if err != nil {
+				return invalidFormat("Date", s, layout, err)
+			}
+			if err := checkBounds("Date", t, s); err != nil {
+				return err
+			}
+			*d = NewDateIn(t, time.UTC)
+		}
+	case *Time:
+		if s == "" {
+			*d = Time{}
+			return nil
+		}
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return invalidFormat("Time", s, layout, err)
+		}
+		*d = NewTime(t)
+	case *Timestamp:
+		switch {
+		case s == "":
+			*d = Timestamp{}
+		case infinite:
+			*d = Timestamp{Time: inf, Valid: true}
+		default:
+			t, err := time.ParseInLocation(layout, s, time.UTC)
+			if err != nil {
+				return invalidFormat("Timestamp", s, layout, err)
+			}
+			if err := checkBounds("Timestamp", t, s); err != nil {
+				return err
+			}
+			*d = NewTimestamp(t)
+		}
+	default:
+		return fmt.Errorf("cannot parse into %T", dst)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+type FormattedBase struct {
+	Created types.Timestamp `json:"created" types:"format=2006-01-02 15:04"`
+}
+
+type formattedPayload struct {
+	Due      types.Date      `json:"due" types:"format=02.01.2006"`
+	Start    types.Time      `json:"start" types:"notnull;format=3:04PM"`
+	Optional types.Date      `json:"optional,omitempty" types:"format=02.01.2006"`
+	Plain    types.Date      `json:"plain"`
+	Count    int             `json:"count,string"`
+	Nested   formattedNested `json:"nested"`
+	*FormattedBase
+}
+
+// formattedHidden is unexported, so a nil pointer to it cannot be allocated.
+type formattedHidden struct {
+	At types.Date `json:"at" types:"format=02.01.2006"`
+}
+
+type formattedNested struct {
+	At types.Date `json:"at" types:"format=02.01.2006"`
+}
+
+func TestMarshalFormatted(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	tests := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{
+			name: "layouts",
+			in: formattedPayload{
+				Due:           date(2024, time.February, 29),
+				Start:         types.NewTime(time.Date(1, 1, 1, 13, 5, 0, 0, time.UTC)),
+				Optional:      date(2024, time.March, 1),
+				Plain:         date(2024, time.February, 29),
+				Count:         3,
+				Nested:        formattedNested{At: date(2024, time.February, 29)},
+				FormattedBase: &FormattedBase{Created: types.NewTimestamp(time.Date(2024, 2, 29, 13, 5, 0, 0, berlin))},
+			},
+			want: `{"due":"29.02.2024","start":"1:05PM","optional":"01.03.2024","plain":"2024-02-29","count":"3","nested":{"at":"2024-02-29"},"created":"2024-02-29 12:05"}`,
+		},
+		{
+			name: "invalid and nil embedded pointer",
+			in:   &formattedPayload{},
+			want: `{"due":null,"start":null,"plain":null,"count":"0","nested":{"at":null},"created":null}`,
+		},
+		{
+			name: "infinity",
+			in:   formattedPayload{Due: types.DateInfinity(), FormattedBase: &FormattedBase{Created: types.TimestampNegativeInfinity()}},
+			want: `{"due":"infinity","start":null,"plain":null,"count":"0","nested":{"at":null},"created":"-infinity"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := types.MarshalFormatted(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalFormatted =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := types.MarshalFormatted([]int{1}); err == nil {
+		t.Error("MarshalFormatted([]int) succeeded, want error")
+	}
+}
+
+func TestUnmarshalFormatted(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want formattedPayload
+	}{
+		{
+			name: "layouts",
+			in:   `{"due":"29.02.2024","start":"1:05PM","plain":"2024-02-29","count":"3","created":"2024-02-29 12:05"}`,
+			want: formattedPayload{
+				Due:           date(2024, time.February, 29),
+				Start:         types.NewTime(time.Date(1, 1, 1, 13, 5, 0, 0, time.UTC)),
+				Plain:         date(2024, time.February, 29),
+				Count:         3,
+				FormattedBase: &FormattedBase{Created: ts(2024, time.February, 29, 12, 5)},
+			},
+		},
+		{
+			name: "null and empty",
+			in:   `{"due":null,"start":"","optional":null}`,
+			want: formattedPayload{},
+		},
+		{
+			name: "infinity",
+			in:   `{"due":"-infinity","created":"infinity"}`,
+			want: formattedPayload{Due: types.DateNegativeInfinity(), FormattedBase: &FormattedBase{Created: types.TimestampInfinity()}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got formattedPayload
+			if err := types.UnmarshalFormatted([]byte(tt.in), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Due.Equal(tt.want.Due) || !got.Start.Equal(tt.want.Start) || !got.Optional.Equal(tt.want.Optional) ||
+				!got.Plain.Equal(tt.want.Plain) || got.Count != tt.want.Count {
+				t.Errorf("UnmarshalFormatted(%s) = %+v, want %+v", tt.in, got, tt.want)
+			}
+			if (got.FormattedBase == nil) != (tt.want.FormattedBase == nil) {
+				t.Fatalf("UnmarshalFormatted(%s) embedded pointer = %v, want %v", tt.in, got.FormattedBase, tt.want.FormattedBase)
+			}
+			if got.FormattedBase != nil && !got.Created.Equal(tt.want.Created) {
+				t.Errorf("UnmarshalFormatted(%s) created = %v, want %v", tt.in, got.Created, tt.want.Created)
+			}
+		})
+	}
+}
+
+func TestUnmarshalFormattedKeepsAbsentFields(t *testing.T) {
+	got := formattedPayload{Due: date(2024, time.January, 1), Count: 7}
+	if err := types.UnmarshalFormatted([]byte(`{"plain":"2024-02-29"}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Due.Equal(date(2024, time.January, 1)) || got.Count != 7 || !got.Plain.Equal(date(2024, time.February, 29)) {
+		t.Errorf("UnmarshalFormatted = %+v, want due and count unchanged", got)
+	}
+}
+
+func TestUnmarshalFormattedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		typ  string // ErrInvalidFormat Type, or "" for any error
+	}{
+		{"date in default layout", `{"due":"2024-02-29"}`, "Date"},
+		{"impossible date", `{"due":"30.02.2024"}`, "Date"},
+		{"time", `{"start":"13:05"}`, "Time"},
+		{"timestamp", `{"created":"2024-02-29T12:05:00Z"}`, "Timestamp"},
+		{"not a string", `{"due":29022024}`, ""},
+		{"malformed JSON", `{"due":`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got formattedPayload
+			err := types.UnmarshalFormatted([]byte(tt.in), &got)
+			if err == nil {
+				t.Fatalf("UnmarshalFormatted(%s) succeeded, want error", tt.in)
+			}
+			if tt.typ != "" && !errors.Is(err, &types.ErrInvalidFormat{Type: tt.typ}) {
+				t.Errorf("UnmarshalFormatted(%s) error %v is not a %s ErrInvalidFormat", tt.in, err, tt.typ)
+			}
+		})
+	}
+
+	var hidden struct{ *formattedHidden }
+	if err := types.UnmarshalFormatted([]byte(`{"at":"29.02.2024"}`), &hidden); err == nil {
+		t.Error("UnmarshalFormatted through a nil unexported embedded pointer succeeded, want error")
+	}
+	if err := types.UnmarshalFormatted([]byte(`{}`), &hidden); err != nil || hidden.formattedHidden != nil {
+		t.Errorf("UnmarshalFormatted without the field = %v, want the pointer left nil", err)
+	}
+
+	var notStruct []int
+	if err := types.UnmarshalFormatted([]byte(`[]`), &notStruct); err == nil {
+		t.Error("UnmarshalFormatted(*[]int) succeeded, want error")
+	}
+	if err := types.UnmarshalFormatted([]byte(`{}`), formattedPayload{}); err == nil || !strings.Contains(err.Error(), "pointer") {
+		t.Errorf("UnmarshalFormatted(non-pointer) error = %v, want error", err)
+	}
+}
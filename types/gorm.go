@@ -0,0 +1,24 @@
+package types
+
+// GormDataType implements GORM's schema.GormDataTypeInterface interface.
+// It returns the general data type used when the dialect is not recognized.
+// Dialect-specific column types are provided by package typesgorm.
+func (Date) GormDataType() string {
+	return "date"
+}
+
+// GormDataType implements GORM's schema.GormDataTypeInterface interface.
+// Time is stored as a string, as GORM has no general time-of-day data type.
+func (Time) GormDataType() string {
+	return "string"
+}
+
+// GormDataType implements GORM's schema.GormDataTypeInterface interface.
+func (Timestamp) GormDataType() string {
+	return "time"
+}
+
+// GormDataType implements GORM's schema.GormDataTypeInterface interface.
+func (String) GormDataType() string {
+	return "string"
+}
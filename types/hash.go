@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+)
+
+// Normalized returns d as midnight UTC of its calendar date, so that equal
+// Dates are == and usable as map keys. An invalid Date yields the zero Date.
+//
+// Date, Time and Timestamp hold a time.Time, which == compares including its
+// location and monotonic clock reading. Values parsed from text, JSON or
+// strings scanned from the database are already normalized, as are those
+// returned by NewTime and NewTimestamp. CompactDate and CompactTime are
+// comparable by construction.
+func (d Date) Normalized() Date {
+	if !d.Valid {
+		return Date{}
+	}
+	y, m, day := d.Time.Date()
+	return Date{Time: time.Date(y, m, day, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// Normalized returns t's time of day on January 1st of year 1 in UTC, with
+// seconds dropped, so that equal Times are ==. An invalid Time yields the zero Time.
+func (t Time) Normalized() Time {
+	if !t.Valid {
+		return Time{}
+	}
+	return NewTime(t.Time)
+}
+
+// Normalized returns t in UTC without a monotonic clock reading, so that equal
+// Timestamps are ==. Unlike NewTimestamp, it keeps fractional seconds. An
+// invalid Timestamp yields the zero Timestamp.
+func (t Timestamp) Normalized() Timestamp {
+	if !t.Valid {
+		return Timestamp{}
+	}
+	return Timestamp{Time: t.Time.UTC(), Valid: true}
+}
+
+// Hash returns a hash of the calendar date. All invalid Dates hash to 0.
+func (d Date) Hash() uint64 {
+	if !d.Valid {
+		return 0
+	}
+	y, m, day := d.Time.Date()
+	return hashInt(int64(y)<<9 | int64(m)<<5 | int64(day))
+}
+
+// Hash returns a hash of the time of day (HH:MM). All invalid Times hash to 0.
+func (t Time) Hash() uint64 {
+	if !t.Valid {
+		return 0
+	}
+	h, m, _ := t.Time.Clock()
+	return hashInt(int64(h*60 + m))
+}
+
+// Hash returns a hash of the instant, regardless of location. All invalid
+// Timestamps hash to 0.
+func (t Timestamp) Hash() uint64 {
+	if !t.Valid {
+		return 0
+	}
+	return hashInt(t.Time.Unix(), int64(t.Time.Nanosecond()))
+}
+
+// Hash returns a hash of the string. All invalid Strings hash to 0.
+func (s String) Hash() uint64 {
+	if !s.Valid {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(s.Val))
+	return h.Sum64()
+}
+
+// Hash returns a hash of the dates in the set. All NULL sets hash to 0.
+func (s DateSet) Hash() uint64 {
+	if !s.valid {
+		return 0
+	}
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, d := range s.dates {
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], d.Hash()))
+	}
+	return h.Sum64()
+}
+
+// Hash returns the same hash as Date.Hash for the same date.
+func (c CompactDate) Hash() uint64 {
+	return c.Date().Hash()
+}
+
+// Hash returns the same hash as Time.Hash for the same time of day.
+func (c CompactTime) Hash() uint64 {
+	return c.Time().Hash()
+}
+
+// hashInt returns the FNV-1a hash of the little-endian encoding of vs.
+func hashInt(vs ...int64) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, v := range vs {
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(v)))
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HolidayProvider reports whether a date is a holiday. Implementations can
+// wrap national holiday data, a company calendar or a database table;
+// HolidayCalendar is a rule-based implementation with built-in regions.
+type HolidayProvider interface {
+	// Holiday returns the name of the holiday on d and true, or "" and false
+	// if d is not a holiday. An invalid d is never a holiday.
+	Holiday(d Date) (name string, ok bool)
+}
+
+// IsHoliday reports whether the Date is a holiday according to p.
+// An invalid or infinite Date is never a holiday.
+func (d Date) IsHoliday(p HolidayProvider) bool {
+	if !d.Valid || d.IsInfinite() {
+		return false
+	}
+	_, ok := p.Holiday(d)
+	return ok
+}
+
+// HolidayRule describes one recurring holiday.
+type HolidayRule struct {
+	Name string
+
+	// Date returns the date of the holiday in year, or an invalid Date if it
+	// is not observed that year.
+	Date func(year int) Date
+}
+
+// FixedHoliday returns a rule for a holiday on the same day every year, such as Christmas.
+func FixedHoliday(name string, month time.Month, day int) HolidayRule {
+	return HolidayRule{Name: name, Date: func(year int) Date {
+		if day < 1 || day > daysIn(month, year) {
+			return Date{}
+		}
+		return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Valid: true}
+	}}
+}
+
+// WeekdayHoliday returns a rule for a holiday on the nth weekday of a month,
+// such as Thanksgiving on the fourth Thursday of November. See NthWeekdayOfMonth.
+func WeekdayHoliday(name string, month time.Month, wd time.Weekday, n int) HolidayRule {
+	return HolidayRule{Name: name, Date: func(year int) Date {
+		return NthWeekdayOfMonth(year, month, wd, n)
+	}}
+}
+
+// EasterHoliday returns a rule for a holiday offset days from Western Easter
+// Sunday, such as Good Friday (-2) or Whit Monday (50).
+func EasterHoliday(name string, offset int) HolidayRule {
+	return HolidayRule{Name: name, Date: func(year int) Date {
+		return Date{Time: easterSunday(year).AddDate(0, 0, offset), Valid: true}
+	}}
+}
+
+// observedSince returns r restricted to the years from first on, for holidays
+// introduced after the calendar's other rules.
+func observedSince(first int, r HolidayRule) HolidayRule {
+	date := r.Date
+	r.Date = func(year int) Date {
+		if year < first {
+			return Date{}
+		}
+		return date(year)
+	}
+	return r
+}
+
+// easterSunday returns the date of Western Easter in year, using the
+// anonymous Gregorian algorithm.
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// HolidayCalendar is a HolidayProvider built from HolidayRules.
+// Holidays falling on a weekend are not moved to a substitute day; add a rule
+// for the substitute day where a region observes one.
+type HolidayCalendar struct {
+	Rules []HolidayRule
+}
+
+// NewHolidayCalendar returns a calendar with the public holidays of region,
+// one of "US" (federal holidays), "GB" or "UK" (England and Wales bank
+// holidays), "DE" (nationwide holidays) and "FR". Region codes are
+// case-insensitive. Further rules can be appended to the calendar's Rules.
+func NewHolidayCalendar(region string) (*HolidayCalendar, error) {
+	rules, ok := holidayRegions[strings.ToUpper(region)]
+	if !ok {
+		return nil, fmt.Errorf("unknown holiday region %q", region)
+	}
+	return &HolidayCalendar{Rules: append([]HolidayRule(nil), rules...)}, nil
+}
+
+// Holiday implements the HolidayProvider interface. If several rules fall on
+// d, the name of the first is returned.
+func (c *HolidayCalendar) Holiday(d Date) (string, bool) {
+	if !d.Valid || d.IsInfinite() {
+		return "", false
+	}
+	y, m, day := d.Time.Date()
+	for _, r := range c.Rules {
+		h := r.Date(y)
+		if !h.Valid {
+			continue
+		}
+		if hy, hm, hd := h.Time.Date(); hy == y && hm == m && hd == day {
+			return r.Name, true
+		}
+	}
+	return "", false
+}
+
+var holidayRegions = map[string][]HolidayRule{
+	"US": {
+		FixedHoliday("New Year's Day", time.January, 1),
+		WeekdayHoliday("Martin Luther King Jr. Day", time.January, time.Monday, 3),
+		WeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+		WeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+		observedSince(2021, FixedHoliday("Juneteenth", time.June, 19)),
+		FixedHoliday("Independence Day", time.July, 4),
+		WeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+		WeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+		FixedHoliday("Veterans Day", time.November, 11),
+		WeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+		FixedHoliday("Christmas Day", time.December, 25),
+	},
+	"GB": holidaysGB,
+	"UK": holidaysGB,
+	"DE": {
+		FixedHoliday("New Year's Day", time.January, 1),
+		EasterHoliday("Good Friday", -2),
+		EasterHoliday("Easter Monday", 1),
+		FixedHoliday("Labour Day", time.May, 1),
+		EasterHoliday("Ascension Day", 39),
+		EasterHoliday("Whit Monday", 50),
+		FixedHoliday("German Unity Day", time.October, 3),
+		FixedHoliday("Christmas Day", time.December, 25),
+		FixedHoliday("Boxing Day", time.December, 26),
+	},
+	"FR": {
+		FixedHoliday("New Year's Day", time.January, 1),
+		EasterHoliday("Easter Monday", 1),
+		FixedHoliday("Labour Day", time.May, 1),
+		FixedHoliday("Victory in Europe Day", time.May, 8),
+		EasterHoliday("Ascension Day", 39),
+		EasterHoliday("Whit Monday", 50),
+		FixedHoliday("Bastille Day", time.July, 14),
+		FixedHoliday("Assumption of Mary", time.August, 15),
+		FixedHoliday("All Saints' Day", time.November, 1),
+		FixedHoliday("Armistice Day", time.November, 11),
+		FixedHoliday("Christmas Day", time.December, 25),
+	},
+}
+
+var holidaysGB = []HolidayRule{
+	FixedHoliday("New Year's Day", time.January, 1),
+	EasterHoliday("Good Friday", -2),
+	EasterHoliday("Easter Monday", 1),
+	WeekdayHoliday("Early May Bank Holiday", time.May, time.Monday, 1),
+	WeekdayHoliday("Spring Bank Holiday", time.May, time.Monday, -1),
+	WeekdayHoliday("Summer Bank Holiday", time.August, time.Monday, -1),
+	FixedHoliday("Christmas Day", time.December, 25),
+	FixedHoliday("Boxing Day", time.December, 26),
+}
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func TestHolidayCalendar(t *testing.T) {
+	tests := []struct {
+		region string
+		date   types.Date
+		want   string // "" if not a holiday
+	}{
+		{"US", date(2024, time.January, 1), "New Year's Day"},
+		{"US", date(2024, time.January, 15), "Martin Luther King Jr. Day"},
+		{"US", date(2024, time.May, 27), "Memorial Day"},
+		{"US", date(2024, time.November, 28), "Thanksgiving Day"},
+		{"US", date(2024, time.November, 21), ""},
+		{"US", date(2021, time.June, 19), "Juneteenth"},
+		{"US", date(2020, time.June, 19), ""},
+		{"us", date(2024, time.July, 4), "Independence Day"},
+		{"GB", date(2024, time.March, 29), "Good Friday"},
+		{"UK", date(2024, time.August, 26), "Summer Bank Holiday"},
+		{"DE", date(2024, time.May, 9), "Ascension Day"},
+		{"DE", date(2024, time.May, 20), "Whit Monday"},
+		{"FR", date(2024, time.July, 14), "Bastille Day"},
+		{"FR", date(2024, time.July, 15), ""},
+		{"US", types.Date{}, ""},
+		{"US", types.DateInfinity(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region+" "+tt.date.String(), func(t *testing.T) {
+			c, err := types.NewHolidayCalendar(tt.region)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name, ok := c.Holiday(tt.date)
+			if name != tt.want || ok != (tt.want != "") {
+				t.Errorf("Holiday(%v) = %q, %v, want %q", tt.date, name, ok, tt.want)
+			}
+			if got := tt.date.IsHoliday(c); got != ok {
+				t.Errorf("IsHoliday(%v) = %v, want %v", tt.date, got, ok)
+			}
+		})
+	}
+}
+
+func TestNewHolidayCalendarUnknownRegion(t *testing.T) {
+	if _, err := types.NewHolidayCalendar("XX"); err == nil {
+		t.Error("NewHolidayCalendar(\"XX\") succeeded, want error")
+	}
+}
+
+func TestHolidayRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule types.HolidayRule
+		year int
+		want types.Date
+	}{
+		{"fixed", types.FixedHoliday("", time.December, 25), 2024, date(2024, time.December, 25)},
+		{"fixed leap day", types.FixedHoliday("", time.February, 29), 2024, date(2024, time.February, 29)},
+		{"fixed leap day in common year", types.FixedHoliday("", time.February, 29), 2023, types.Date{}},
+		{"first weekday", types.WeekdayHoliday("", time.September, time.Monday, 1), 2024, date(2024, time.September, 2)},
+		{"last weekday", types.WeekdayHoliday("", time.May, time.Monday, -1), 2024, date(2024, time.May, 27)},
+		{"fifth weekday missing", types.WeekdayHoliday("", time.February, time.Monday, 5), 2023, types.Date{}},
+		{"Easter Sunday", types.EasterHoliday("", 0), 2024, date(2024, time.March, 31)},
+		{"Easter Sunday in April", types.EasterHoliday("", 0), 2025, date(2025, time.April, 20)},
+		{"Good Friday", types.EasterHoliday("", -2), 2000, date(2000, time.April, 21)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Date(tt.year); !got.Equal(tt.want) {
+				t.Errorf("Date(%d) = %v, want %v", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendarCustomRules(t *testing.T) {
+	c := &types.HolidayCalendar{Rules: []types.HolidayRule{
+		types.FixedHoliday("Founders' Day", time.March, 1),
+		types.FixedHoliday("Also Founders' Day", time.March, 1),
+	}}
+	if name, ok := c.Holiday(date(2024, time.March, 1)); !ok || name != "Founders' Day" {
+		t.Errorf("Holiday = %q, %v, want the first matching rule", name, ok)
+	}
+}
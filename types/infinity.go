@@ -0,0 +1,81 @@
+package types
+
+import "time"
+
+// Textual forms of PostgreSQL's special date and timestamp values.
+const (
+	infinityText    = "infinity"
+	negInfinityText = "-infinity"
+)
+
+// Sentinel times representing infinity and -infinity. They lie just outside
+// the range of PostgreSQL's date and timestamp types, so they never collide
+// with a real value read from the database.
+var (
+	infinityTime    = time.Date(294277, time.January, 1, 0, 0, 0, 0, time.UTC)
+	negInfinityTime = time.Date(-4713, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// DateInfinity returns a valid Date representing PostgreSQL's "infinity",
+// which is later than all other dates.
+func DateInfinity() Date {
+	return Date{Time: infinityTime, Valid: true}
+}
+
+// DateNegativeInfinity returns a valid Date representing PostgreSQL's
+// "-infinity", which is earlier than all other dates.
+func DateNegativeInfinity() Date {
+	return Date{Time: negInfinityTime, Valid: true}
+}
+
+// TimestampInfinity returns a valid Timestamp representing PostgreSQL's
+// "infinity", which is later than all other timestamps.
+func TimestampInfinity() Timestamp {
+	return Timestamp{Time: infinityTime, Valid: true}
+}
+
+// TimestampNegativeInfinity returns a valid Timestamp representing
+// PostgreSQL's "-infinity", which is earlier than all other timestamps.
+func TimestampNegativeInfinity() Timestamp {
+	return Timestamp{Time: negInfinityTime, Valid: true}
+}
+
+// IsInfinite reports whether the Date is valid and represents infinity or -infinity.
+// The sign can be told apart by comparing with DateInfinity.
+func (d Date) IsInfinite() bool {
+	_, ok := infinityString(d.Time, d.Valid)
+	return ok
+}
+
+// IsInfinite reports whether the Timestamp is valid and represents infinity or -infinity.
+// The sign can be told apart by comparing with TimestampInfinity.
+func (t Timestamp) IsInfinite() bool {
+	_, ok := infinityString(t.Time, t.Valid)
+	return ok
+}
+
+// infinityString returns "infinity" or "-infinity" if t is one of the sentinel times.
+func infinityString(t time.Time, valid bool) (string, bool) {
+	switch {
+	case !valid:
+		return "", false
+	case t.Equal(infinityTime):
+		return infinityText, true
+	case t.Equal(negInfinityTime):
+		return negInfinityText, true
+	default:
+		return "", false
+	}
+}
+
+// parseInfinity returns the sentinel time for "infinity" or "-infinity".
+func parseInfinity(s string) (time.Time, bool) {
+	switch s {
+	case infinityText:
+		return infinityTime, true
+	case negInfinityText:
+		return negInfinityTime, true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -0,0 +1,67 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package types
+
+import "encoding/json/jsontext"
+
+// The methods below are used by encoding/json/v2 in place of MarshalJSON and
+// UnmarshalJSON. They write directly into the encoder's buffer and produce and
+// accept the same JSON as their v1 counterparts. They are only built when the
+// toolchain provides encoding/json/jsontext, i.e. Go 1.27 with the jsonv2
+// experiment enabled. Under json/v2, omitzero consults IsZero.
+
+// MarshalJSONTo implements the json.MarshalerTo interface.
+func (d Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteValue(d.AppendJSON(enc.AvailableBuffer()))
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface.
+func (d *Date) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements the json.MarshalerTo interface.
+func (t Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteValue(t.AppendJSON(enc.AvailableBuffer()))
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface.
+func (t *Time) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements the json.MarshalerTo interface.
+func (t Timestamp) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteValue(t.AppendJSON(enc.AvailableBuffer()))
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface.
+func (t *Timestamp) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalJSON(data)
+}
+
+// MarshalJSONTo implements the json.MarshalerTo interface.
+func (s String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteValue(s.AppendJSON(enc.AvailableBuffer()))
+}
+
+// UnmarshalJSONFrom implements the json.UnmarshalerFrom interface.
+func (s *String) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalJSON(data)
+}
@@ -0,0 +1,297 @@
+package types
+
+import (
+	"bytes"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// KSUID is a nullable K-Sortable Unique Identifier: 20 bytes holding a 32-bit
+// creation time in seconds since 2014-05-13T16:53:20Z, followed by 128 random
+// bits. It is stored and encoded in JSON as its 27-character base62 string,
+// which sorts lexicographically in creation order, like Compare.
+type KSUID struct {
+	Val   [ksuidLen]byte
+	Valid bool
+}
+
+const (
+	ksuidLen       = 20
+	ksuidStringLen = 27
+
+	// ksuidEpoch is the Unix time of KSUID timestamp zero.
+	ksuidEpoch = 1400000000
+
+	base62Digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// NewKSUID generates a new valid KSUID for the current time, with a random
+// payload read from crypto/rand.
+func NewKSUID() KSUID {
+	return NewKSUIDAt(time.Now())
+}
+
+// NewKSUIDAt generates a new valid KSUID for t, truncated to the second, with a
+// random payload read from crypto/rand. t must lie within the 136 years after
+// the KSUID epoch; the timestamp wraps around otherwise.
+func NewKSUIDAt(t time.Time) KSUID {
+	var id KSUID
+	binary.BigEndian.PutUint32(id.Val[:4], uint32(t.Unix()-ksuidEpoch))
+	rand.Read(id.Val[4:])
+	id.Valid = true
+	return id
+}
+
+// ParseKSUID parses a 27-character base62 KSUID. An empty string yields an
+// invalid KSUID.
+func ParseKSUID(s string) (KSUID, error) {
+	var id KSUID
+	err := id.parseKSUID(s)
+	return id, err
+}
+
+// NullKSUID returns an invalid (NULL) KSUID. It is equivalent to KSUID{}.
+func NullKSUID() KSUID {
+	return KSUID{}
+}
+
+// parseKSUID parses s into the KSUID, marking it invalid if s is empty.
+func (id *KSUID) parseKSUID(s string) error {
+	if s == "" {
+		*id = KSUID{}
+		return nil
+	}
+	if len(s) != ksuidStringLen {
+		return invalidFormat("KSUID", s, "27 base62 characters", fmt.Errorf("got %d characters", len(s)))
+	}
+	// Accumulate the digits into the bytes, most significant first, multiplying
+	// by 62 and carrying from the least significant byte.
+	var val [ksuidLen]byte
+	for i := 0; i < len(s); i++ {
+		digit := base62Value(s[i])
+		if digit < 0 {
+			return invalidFormat("KSUID", s, "27 base62 characters", fmt.Errorf("invalid character %q", s[i]))
+		}
+		carry := digit
+		for j := ksuidLen - 1; j >= 0; j-- {
+			carry += int(val[j]) * 62
+			val[j] = byte(carry)
+			carry >>= 8
+		}
+		if carry != 0 {
+			return invalidFormat("KSUID", s, "27 base62 characters", errors.New("value out of range"))
+		}
+	}
+	*id = KSUID{Val: val, Valid: true}
+	return nil
+}
+
+// base62Value returns the value of the base62 digit c, or -1 if c is not one.
+func base62Value(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + 36
+	default:
+		return -1
+	}
+}
+
+// appendKSUID appends the base62 encoding of the KSUID to b.
+func (id KSUID) appendKSUID(b []byte) []byte {
+	// Repeatedly divide the bytes by 62, collecting the remainders as the
+	// digits from least significant up.
+	val := id.Val
+	var digits [ksuidStringLen]byte
+	for i := ksuidStringLen - 1; i >= 0; i-- {
+		rem := 0
+		for j := range val {
+			rem = rem<<8 | int(val[j])
+			val[j] = byte(rem / 62)
+			rem %= 62
+		}
+		digits[i] = base62Digits[rem]
+	}
+	return append(b, digits[:]...)
+}
+
+// Timestamp returns the creation time embedded in the KSUID, to the second.
+// An invalid KSUID yields an invalid Timestamp.
+func (id KSUID) Timestamp() Timestamp {
+	if !id.Valid {
+		return Timestamp{}
+	}
+	return NewTimestamp(time.Unix(int64(binary.BigEndian.Uint32(id.Val[:4]))+ksuidEpoch, 0))
+}
+
+// Payload returns the 16 random bytes of the KSUID, or nil if it is invalid.
+func (id KSUID) Payload() []byte {
+	if !id.Valid {
+		return nil
+	}
+	return bytes.Clone(id.Val[4:])
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses database values into a KSUID, supporting NULL, base62 strings, and
+// []byte holding either the base62 string or the 20 raw bytes, as stored in a
+// bytea or binary(20) column. sql.RawBytes is accepted too.
+func (id *KSUID) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*id = KSUID{}
+		return nil
+	case string:
+		return id.parseKSUID(v)
+	case []byte:
+		if len(v) == ksuidLen {
+			*id = KSUID{Valid: true}
+			copy(id.Val[:], v)
+			return nil
+		}
+		return id.parseKSUID(string(v))
+	default:
+		return unsupportedScanType("KSUID", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the KSUID as a base62 string, or nil if invalid.
+func (id KSUID) Value() (driver.Value, error) {
+	if !id.Valid {
+		return nil, nil
+	}
+	return id.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the KSUID as a base62 JSON string, or null if invalid.
+func (id KSUID) MarshalJSON() ([]byte, error) {
+	return id.AppendJSON(make([]byte, 0, ksuidStringLen+2)), nil
+}
+
+// AppendJSON appends the JSON encoding of the KSUID to b, as returned by MarshalJSON.
+func (id KSUID) AppendJSON(b []byte) []byte {
+	if !id.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	b = append(b, '"')
+	b = id.appendKSUID(b)
+	return append(b, '"')
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a JSON string into the KSUID, handling null and empty strings.
+func (id *KSUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = KSUID{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("KSUID", string(data), "JSON string", err)
+	}
+	return id.parseKSUID(s)
+}
+
+// IsZero reports whether the KSUID is invalid.
+func (id KSUID) IsZero() bool {
+	return !id.Valid
+}
+
+// IsNull reports whether the KSUID is invalid (NULL).
+func (id KSUID) IsNull() bool {
+	return !id.Valid
+}
+
+// HasValue reports whether the KSUID is valid (non-NULL). It is the negation of IsNull.
+func (id KSUID) HasValue() bool {
+	return id.Valid
+}
+
+// Equal reports whether id and other are the same KSUID, or both invalid.
+func (id KSUID) Equal(other KSUID) bool {
+	return id == other || (!id.Valid && !other.Valid)
+}
+
+// Compare returns -1, 0 or +1 depending on whether id is less than, equal to or
+// greater than other, which orders KSUIDs by creation time to the second.
+// Invalid KSUIDs sort before all valid KSUIDs.
+func (id KSUID) Compare(other KSUID) int {
+	if c, ok := compareNull(id.Valid, other.Valid); ok {
+		return c
+	}
+	return bytes.Compare(id.Val[:], other.Val[:])
+}
+
+// String returns the KSUID as a 27-character base62 string, or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (id KSUID) String() string {
+	if !id.Valid {
+		return ""
+	}
+	return string(id.appendKSUID(make([]byte, 0, ksuidStringLen)))
+}
+
+// Set implements the flag.Value interface.
+// It parses a base62 KSUID into the KSUID, marking it invalid if the string is empty.
+func (id *KSUID) Set(s string) error {
+	return id.parseKSUID(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (id *KSUID) Type() string {
+	return "ksuid"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid KSUID is encoded as empty text.
+func (id KSUID) MarshalText() ([]byte, error) {
+	return id.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the KSUID as returned by String to b.
+func (id KSUID) AppendText(b []byte) ([]byte, error) {
+	if !id.Valid {
+		return b, nil
+	}
+	return id.appendKSUID(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the KSUID invalid.
+func (id *KSUID) UnmarshalText(text []byte) error {
+	return id.parseKSUID(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a KSUID.
+func (id *KSUID) UnmarshalParam(param string) error {
+	return id.parseKSUID(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the KSUID as a base62 string, or nil if invalid.
+func (id KSUID) LogValue() slog.Value {
+	if !id.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(id.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (id KSUID) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "KSUID", "Val", id.String(), id.Valid)
+}
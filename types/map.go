@@ -0,0 +1,174 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"maps"
+	"reflect"
+)
+
+// Map is a nullable map, e.g. for settings or attributes, stored in a JSONB or
+// JSON column and encoded in JSON as an object. K must be usable as a JSON
+// object key: a string or integer type, or an encoding.TextMarshaler.
+//
+// Keys are written in sorted order, as encoding/json writes maps, so equal
+// Maps always produce identical JSON. A NULL Map differs from an empty one;
+// JSON null, whether a SQL NULL or stored in the column, yields a NULL Map.
+type Map[K comparable, V any] struct {
+	Val   map[K]V
+	Valid bool
+}
+
+// NewMap returns a valid Map holding m. A nil m yields an empty Map.
+func NewMap[K comparable, V any](m map[K]V) Map[K, V] {
+	if m == nil {
+		m = map[K]V{}
+	}
+	return Map[K, V]{Val: m, Valid: true}
+}
+
+// NullMap returns a NULL Map. It is equivalent to Map[K, V]{}.
+func NullMap[K comparable, V any]() Map[K, V] {
+	return Map[K, V]{}
+}
+
+// Len returns the number of entries in the Map.
+func (m Map[K, V]) Len() int {
+	return len(m.Val)
+}
+
+// Get returns the value stored under key, and whether it is present.
+func (m Map[K, V]) Get(key K) (V, bool) {
+	v, ok := m.Val[key]
+	return v, ok
+}
+
+// Set stores v under key, marking the Map valid and allocating it if needed.
+// Like a Go map, the Map shares its entries with copies of itself.
+func (m *Map[K, V]) Set(key K, v V) {
+	if m.Val == nil {
+		m.Val = map[K]V{}
+	}
+	m.Val[key] = v
+	m.Valid = true
+}
+
+// Delete removes the entry stored under key, if any.
+func (m Map[K, V]) Delete(key K) {
+	delete(m.Val, key)
+}
+
+// Scan implements the sql.Scanner interface.
+// It decodes a JSON object from a JSONB or JSON column, handling NULL.
+// sql.RawBytes is accepted too.
+func (m *Map[K, V]) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*m = Map[K, V]{}
+		return nil
+	case []byte:
+		return m.parseJSON(v)
+	case string:
+		return m.parseJSON([]byte(v))
+	default:
+		return unsupportedScanType("Map", value)
+	}
+}
+
+// parseJSON decodes a JSON object, or null, into the Map.
+func (m *Map[K, V]) parseJSON(data []byte) error {
+	var val map[K]V
+	if err := json.Unmarshal(data, &val); err != nil {
+		return invalidFormat("Map", string(data), "JSON object", err)
+	}
+	if val == nil {
+		*m = Map[K, V]{}
+		return nil
+	}
+	*m = Map[K, V]{Val: val, Valid: true}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the Map as a JSON object string, or nil if NULL.
+func (m Map[K, V]) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the Map as a JSON object with sorted keys, or null if NULL.
+func (m Map[K, V]) MarshalJSON() ([]byte, error) {
+	if !m.Valid {
+		return appendNullJSON(nil, zeroMapJSON), nil
+	}
+	if m.Val == nil {
+		return []byte(`{}`), nil
+	}
+	return json.Marshal(m.Val)
+}
+
+// AppendJSON appends the JSON encoding of the Map to b, as returned by
+// MarshalJSON. If the entries cannot be encoded, null is appended instead.
+func (m Map[K, V]) AppendJSON(b []byte) []byte {
+	data, err := m.MarshalJSON()
+	if err != nil {
+		return append(b, "null"...)
+	}
+	return append(b, data...)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON object into the Map, handling null.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	return m.parseJSON(data)
+}
+
+// IsZero reports whether the Map is NULL or empty, so that it is omitted by
+// omitzero under encoding/json/v2.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL Map is zero.
+func (m Map[K, V]) IsZero() bool {
+	return isZero(m.Valid, len(m.Val) == 0)
+}
+
+// IsNull reports whether the Map is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (m Map[K, V]) IsNull() bool {
+	return !m.Valid
+}
+
+// HasValue reports whether the Map is valid (non-NULL). It is the negation of IsNull.
+func (m Map[K, V]) HasValue() bool {
+	return m.Valid
+}
+
+// Equal reports whether m and other hold the same keys with deeply equal
+// values, as reported by reflect.DeepEqual, or are both NULL. An empty Map is
+// not equal to a NULL one.
+func (m Map[K, V]) Equal(other Map[K, V]) bool {
+	if !m.Valid || !other.Valid {
+		return m.Valid == other.Valid
+	}
+	return maps.EqualFunc(m.Val, other.Val, func(a, b V) bool {
+		return reflect.DeepEqual(a, b)
+	})
+}
+
+// Validate returns an *ErrInvalidFormat if the Map is valid but cannot be
+// encoded in JSON, e.g. because a value holds a channel or a NaN.
+func (m Map[K, V]) Validate() error {
+	if !m.Valid {
+		return nil
+	}
+	if _, err := json.Marshal(m.Val); err != nil {
+		return invalidFormat("Map", "", "JSON object", err)
+	}
+	return nil
+}
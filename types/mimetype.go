@@ -0,0 +1,244 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"strings"
+)
+
+// MIMEType is a nullable media type such as "image/png" or
+// "text/plain; charset=utf-8", e.g. for upload services. It is stored and
+// encoded in JSON as a string.
+//
+// Input is validated with mime.ParseMediaType and normalized: the type,
+// subtype and parameter names are lowercased, parameters are sorted by name,
+// and values are quoted only where needed. Use WithoutParams to drop the
+// parameters. Empty text yields an invalid MIMEType.
+type MIMEType struct {
+	Val   string
+	Valid bool
+}
+
+// ParseMIMEType parses and normalizes a media type. An empty string yields an
+// invalid MIMEType.
+func ParseMIMEType(s string) (MIMEType, error) {
+	var m MIMEType
+	err := m.parseMIMEType(s)
+	return m, err
+}
+
+// NullMIMEType returns an invalid (NULL) MIMEType. It is equivalent to MIMEType{}.
+func NullMIMEType() MIMEType {
+	return MIMEType{}
+}
+
+// parseMIMEType parses s into the MIMEType, marking it invalid if s is empty.
+func (m *MIMEType) parseMIMEType(s string) error {
+	if s == "" {
+		*m = MIMEType{}
+		return nil
+	}
+	mediaType, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return invalidFormat("MIMEType", s, "type/subtype", err)
+	}
+	if typ, sub, ok := strings.Cut(mediaType, "/"); !ok || typ == "" || sub == "" {
+		return invalidFormat("MIMEType", s, "type/subtype", errors.New("missing subtype"))
+	}
+	val := mime.FormatMediaType(mediaType, params)
+	if val == "" {
+		return invalidFormat("MIMEType", s, "type/subtype", errors.New("invalid parameter"))
+	}
+	*m = MIMEType{Val: val, Valid: true}
+	return nil
+}
+
+// MediaType returns the type and subtype, e.g. "text/plain", without
+// parameters, or "" if invalid.
+func (m MIMEType) MediaType() string {
+	if !m.Valid {
+		return ""
+	}
+	mediaType, _, _ := strings.Cut(m.Val, ";")
+	return mediaType
+}
+
+// Params returns the parameters, such as charset, or nil if there are none
+// or the MIMEType is invalid.
+func (m MIMEType) Params() map[string]string {
+	if !m.Valid || !strings.Contains(m.Val, ";") {
+		return nil
+	}
+	_, params, _ := mime.ParseMediaType(m.Val)
+	return params
+}
+
+// WithoutParams returns the MIMEType with its parameters removed.
+func (m MIMEType) WithoutParams() MIMEType {
+	if !m.Valid {
+		return m
+	}
+	return MIMEType{Val: m.MediaType(), Valid: true}
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses database values into a MIMEType, supporting NULL, string, and []byte.
+// sql.RawBytes is accepted too.
+func (m *MIMEType) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*m = MIMEType{}
+		return nil
+	case string:
+		return m.parseMIMEType(v)
+	case []byte:
+		return m.parseMIMEType(string(v))
+	default:
+		return unsupportedScanType("MIMEType", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the media type as a string, or nil if invalid.
+func (m MIMEType) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+	return m.Val, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the media type as a JSON string, or null if invalid.
+func (m MIMEType) MarshalJSON() ([]byte, error) {
+	return m.AppendJSON(make([]byte, 0, len(m.Val)+2)), nil
+}
+
+// AppendJSON appends the JSON encoding of the MIMEType to b, as returned by MarshalJSON.
+func (m MIMEType) AppendJSON(b []byte) []byte {
+	if !m.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	return appendJSONString(b, m.Val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a JSON string into the MIMEType, handling null and empty strings.
+func (m *MIMEType) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = MIMEType{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("MIMEType", string(data), "JSON string", err)
+	}
+	return m.parseMIMEType(s)
+}
+
+// IsZero reports whether the MIMEType is invalid or empty.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid MIMEType is zero.
+func (m MIMEType) IsZero() bool {
+	return isZero(m.Valid, m.Val == "")
+}
+
+// IsNull reports whether the MIMEType is invalid (NULL).
+func (m MIMEType) IsNull() bool {
+	return !m.Valid
+}
+
+// HasValue reports whether the MIMEType is valid (non-NULL). It is the negation of IsNull.
+func (m MIMEType) HasValue() bool {
+	return m.Valid
+}
+
+// Equal reports whether m and other hold the same media type and parameters,
+// or are both invalid.
+func (m MIMEType) Equal(other MIMEType) bool {
+	if !m.Valid || !other.Valid {
+		return m.Valid == other.Valid
+	}
+	return m.Val == other.Val
+}
+
+// Validate returns an *ErrInvalidFormat if the MIMEType is valid but does not
+// hold a normalized media type, e.g. because Val was set directly.
+func (m MIMEType) Validate() error {
+	if !m.Valid {
+		return nil
+	}
+	parsed, err := ParseMIMEType(m.Val)
+	if err != nil {
+		return err
+	}
+	if parsed.Val != m.Val {
+		return invalidFormat("MIMEType", m.Val, "type/subtype", errors.New("not normalized"))
+	}
+	return nil
+}
+
+// String returns the media type, or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (m MIMEType) String() string {
+	if !m.Valid {
+		return ""
+	}
+	return m.Val
+}
+
+// Set implements the flag.Value interface.
+// It parses the media type into the MIMEType, marking it invalid if the string is empty.
+func (m *MIMEType) Set(s string) error {
+	return m.parseMIMEType(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (m *MIMEType) Type() string {
+	return "mimetype"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid MIMEType is encoded as empty text.
+func (m MIMEType) MarshalText() ([]byte, error) {
+	return m.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the media type to b, or nothing if invalid.
+func (m MIMEType) AppendText(b []byte) ([]byte, error) {
+	if !m.Valid {
+		return b, nil
+	}
+	return append(b, m.Val...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the MIMEType invalid.
+func (m *MIMEType) UnmarshalText(text []byte) error {
+	return m.parseMIMEType(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a MIMEType.
+func (m *MIMEType) UnmarshalParam(param string) error {
+	return m.parseMIMEType(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the media type, or nil if invalid.
+func (m MIMEType) LogValue() slog.Value {
+	if !m.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(m.Val)
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (m MIMEType) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "MIMEType", "Val", m.String(), m.Valid)
+}
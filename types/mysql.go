@@ -0,0 +1,22 @@
+package types
+
+import "strings"
+
+// mysqlZeroDate is the date part of MySQL's zero value for DATE, DATETIME and TIMESTAMP columns.
+const mysqlZeroDate = "0000-00-00"
+
+// isMySQLZeroDate reports whether s is a MySQL zero date, such as "0000-00-00"
+// or "0000-00-00 00:00:00.000000".
+func isMySQLZeroDate(s string) bool {
+	rest, ok := strings.CutPrefix(s, mysqlZeroDate)
+	if !ok {
+		return false
+	}
+	return strings.Trim(rest, "0:. T") == ""
+}
+
+// scanZeroDate reports whether s should be scanned as NULL because it is a
+// MySQL zero date and SetMySQLZeroDates is enabled.
+func scanZeroDate(s string) bool {
+	return mysqlZeroDates.Load() && isMySQLZeroDate(s)
+}
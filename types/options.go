@@ -0,0 +1,223 @@
+package types
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Package-wide settings. They are safe to change concurrently, but are meant to
+// be configured once during program startup.
+var (
+	protoJSON       atomic.Bool
+	dialect         atomic.Int32
+	sqliteStorage   atomic.Int32
+	mysqlZeroDates  atomic.Bool
+	zeroCopyScan    atomic.Bool
+	strictJSON      atomic.Bool
+	strictTime      atomic.Bool
+	nullAsZero      atomic.Bool
+	canonicalOutput atomic.Bool
+
+	dateValueKind      atomic.Int32
+	timeValueKind      atomic.Int32
+	timestampValueKind atomic.Int32
+
+	timestampEpochUnit atomic.Int32
+	zeroPolicy         atomic.Int32
+	dateScanPolicy     atomic.Int32
+	newDateLocation    atomic.Pointer[time.Location]
+	snowflakeEpoch     atomic.Pointer[time.Time]
+	tagRules           atomic.Pointer[TagRules]
+
+	dateLayout      atomic.Pointer[string]
+	timeLayout      atomic.Pointer[string]
+	timestampLayout atomic.Pointer[string]
+)
+
+// SetProtoJSON selects JSON output matching the protojson conventions used by
+// gRPC-gateway: Date is encoded in google.type.Date object form and Time in
+// google.type.TimeOfDay object form. Both object forms are always accepted by
+// UnmarshalJSON, regardless of this setting.
+func SetProtoJSON(enabled bool) {
+	protoJSON.Store(enabled)
+}
+
+// SetDialect selects the SQL dialect whose formats Scan accepts and Value emits.
+// The default is Generic.
+func SetDialect(d Dialect) {
+	dialect.Store(int32(d))
+}
+
+// SetSQLiteStorage selects whether Date and Timestamp values are sent to SQLite
+// as text, Unix seconds or Julian day numbers. It only takes effect with the
+// SQLite dialect. Scan accepts all three representations regardless.
+func SetSQLiteStorage(s SQLiteStorage) {
+	sqliteStorage.Store(int32(s))
+}
+
+// SetMySQLZeroDates selects whether Scan treats MySQL zero dates such as
+// "0000-00-00" and "0000-00-00 00:00:00" as NULL, producing an invalid Date or
+// Timestamp. A zero time.Time, which the MySQL driver returns for zero dates
+// when parseTime is set, is treated as NULL as well. When disabled (the
+// default), scanning a zero date fails.
+func SetMySQLZeroDates(enabled bool) {
+	mysqlZeroDates.Store(enabled)
+}
+
+// SetDateValueKind selects whether Date.Value passes a string or a time.Time
+// to the driver. The default is DialectValue.
+func SetDateValueKind(k ValueKind) {
+	dateValueKind.Store(int32(k))
+}
+
+// SetTimeValueKind selects whether Time.Value passes a string or a time.Time
+// to the driver. The default is DialectValue.
+func SetTimeValueKind(k ValueKind) {
+	timeValueKind.Store(int32(k))
+}
+
+// SetTimestampValueKind selects whether Timestamp.Value passes a string or a
+// time.Time to the driver. The default is DialectValue.
+func SetTimestampValueKind(k ValueKind) {
+	timestampValueKind.Store(int32(k))
+}
+
+// SetZeroCopyScan selects whether String.Scan aliases []byte values from the
+// driver instead of copying them. This saves the copy on wide text rows, but is
+// unsafe: database/sql only guarantees the bytes until the next call to Next,
+// Scan or Close on the rows, after which the String may change under the caller
+// or point to freed memory. Only enable it if every scanned String is consumed
+// before the cursor moves, or retained only via String.Clone. The default is to copy.
+func SetZeroCopyScan(enabled bool) {
+	zeroCopyScan.Store(enabled)
+}
+
+// SetStrictJSON selects strict parsing in UnmarshalJSON. Date, Time and
+// Timestamp then decode their input as a JSON string, rejecting invalid escapes,
+// trailing garbage and non-string tokens other than the protojson object forms
+// and Timestamp's epoch numbers,
+// and Time rejects input other than HH:MM (or the layout set with SetTimeFormat)
+// instead of ignoring everything after the fifth character. The default is lenient.
+func SetStrictJSON(enabled bool) {
+	strictJSON.Store(enabled)
+}
+
+// SetStrictTime selects strict parsing of Time text by Scan, UnmarshalText, Set,
+// UnmarshalParam and lenient UnmarshalJSON: only HH:MM, HH:MM:SS and HH:MM:SS
+// with a fraction, or the layout set with SetTimeFormat, are accepted, instead
+// of ignoring anything after the fifth character, such as in "15:0499garbage".
+// The default is lenient.
+func SetStrictTime(enabled bool) {
+	strictTime.Store(enabled)
+}
+
+// SetNullAsZeroJSON selects whether MarshalJSON encodes invalid values as the
+// zero literal of their type instead of null, for consumers that cannot handle
+// nulls: "" for String and the other text types, "0001-01-01" for Date, "00:00"
+// for Time, "0001-01-01T00:00:00Z" for Timestamp, [] for DateSet and the slice
+// types, {} for Map, and an empty range for Int64Range and DecimalRange.
+// UnmarshalJSON is not affected. Use ZeroJSON to select this per field
+// instead. The default is null.
+func SetNullAsZeroJSON(enabled bool) {
+	nullAsZero.Store(enabled)
+}
+
+// SetTimestampEpochUnit selects whether Timestamp.UnmarshalJSON reads bare JSON
+// numbers as Unix seconds or milliseconds. The default, EpochAuto, decides by
+// magnitude.
+func SetTimestampEpochUnit(u EpochUnit) {
+	timestampEpochUnit.Store(int32(u))
+}
+
+// SetZeroPolicy selects whether IsZero reports only invalid values, or also
+// valid values holding their type's zero value. The default is NullOrZeroValue.
+func SetZeroPolicy(p ZeroPolicy) {
+	zeroPolicy.Store(int32(p))
+}
+
+// SetDateScanPolicy selects whether Date.Scan silently discards the time of day
+// of a timestamp, such as one read from a timestamptz column mapped to a Date,
+// logs a warning, or fails. The default is DiscardTimeOfDay.
+func SetDateScanPolicy(p DateScanPolicy) {
+	dateScanPolicy.Store(int32(p))
+}
+
+// SetNewDateLocation makes NewDate take the calendar date of its argument in
+// loc, like NewDateIn, instead of truncating it to midnight UTC. A nil loc
+// restores truncation, the default. Scan and parsing are not affected.
+func SetNewDateLocation(loc *time.Location) {
+	newDateLocation.Store(loc)
+}
+
+// SetSnowflakeEpoch selects the epoch SnowflakeID.Timestamp measures creation
+// times from, e.g. 2015-01-01T00:00:00Z for Discord. The default is Twitter's,
+// 2010-11-04T01:42:54.657Z.
+func SetSnowflakeEpoch(epoch time.Time) {
+	snowflakeEpoch.Store(&epoch)
+}
+
+// SetTagRules selects the normalization rules Tags applies on input. The
+// default trims and lowercases tags. Tags already held are not renormalized.
+func SetTagRules(r TagRules) {
+	tagRules.Store(&r)
+}
+
+// SetCanonicalOutput selects a canonical encoding for MarshalJSON, MarshalText
+// and String, so equal values always produce identical bytes, e.g. for content
+// hashing and signatures. Output then ignores SetDateFormat, SetTimeFormat,
+// SetTimestampFormat, SetProtoJSON and SetNullAsZeroJSON: Date is YYYY-MM-DD,
+// Time HH:MM, Timestamp RFC3339 in UTC with whole seconds, and invalid values
+// null (or empty text). Parsing and Value are not affected.
+func SetCanonicalOutput(enabled bool) {
+	canonicalOutput.Store(enabled)
+}
+
+// SetDateFormat selects the time.Parse layout, e.g. "02.01.2006", used by
+// Date's String, MarshalJSON, MarshalText and Value, and accepted in addition
+// to YYYY-MM-DD when parsing. An empty layout restores YYYY-MM-DD.
+// Infinite dates and protojson output are not affected.
+func SetDateFormat(layout string) {
+	storeLayout(&dateLayout, layout)
+}
+
+// SetTimeFormat selects the time.Parse layout, e.g. "3:04 PM", used by Time's
+// String, MarshalJSON, MarshalText and Value, and accepted in addition to HH:MM
+// when parsing. Only hours and minutes are kept. An empty layout restores HH:MM
+// and the dialect's Value format.
+func SetTimeFormat(layout string) {
+	storeLayout(&timeLayout, layout)
+}
+
+// SetTimestampFormat selects the time.Parse layout used by Timestamp's String,
+// MarshalJSON and MarshalText, and by Value wherever it passes a string, and
+// accepted in addition to RFC3339 when parsing. Timestamps are formatted in UTC,
+// and parsed as UTC if the layout has no zone. An empty layout restores RFC3339.
+func SetTimestampFormat(layout string) {
+	storeLayout(&timestampLayout, layout)
+}
+
+// storeLayout sets a layout override, clearing it if layout is empty.
+func storeLayout(p *atomic.Pointer[string], layout string) {
+	if layout == "" {
+		p.Store(nil)
+		return
+	}
+	p.Store(&layout)
+}
+
+// outputLayout returns the layout used for output: the one set with
+// storeLayout, or "" if none or if SetCanonicalOutput is enabled.
+func outputLayout(p *atomic.Pointer[string]) string {
+	if canonicalOutput.Load() {
+		return ""
+	}
+	return loadLayout(p)
+}
+
+// loadLayout returns the layout set with storeLayout, or "" if none.
+func loadLayout(p *atomic.Pointer[string]) string {
+	if l := p.Load(); l != nil {
+		return *l
+	}
+	return ""
+}
@@ -0,0 +1,179 @@
+package types
+
+import "time"
+
+// parseDateFast parses a date in the fixed YYYY-MM-DD layout without going
+// through time.Parse, which dominates the cost of scanning dates in bulk.
+// It reports false for anything time.Parse(dateFormat, s) would reject.
+func parseDateFast(s string) (time.Time, bool) {
+	if len(s) != len(dateFormat) || s[4] != '-' || s[7] != '-' {
+		return time.Time{}, false
+	}
+	year, ok1 := atoi(s[0:4])
+	month, ok2 := atoi(s[5:7])
+	day, ok3 := atoi(s[8:10])
+	if !ok1 || !ok2 || !ok3 || month < 1 || month > 12 || day < 1 || day > daysIn(time.Month(month), year) {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+// atoi parses a string of ASCII digits, reporting false if it contains
+// anything else.
+func atoi(s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, true
+}
+
+// daysIn returns the number of days in the month of the given year.
+func daysIn(m time.Month, year int) int {
+	if m == time.February {
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	}
+	return 31 - int(m-1)%7%2
+}
+
+// parseTimeFast parses a time of day in the HH:MM or HH:MM:SS layout, the
+// latter optionally followed by fractional seconds, without going through
+// time.Parse. Seconds are validated but discarded. It reports false for
+// anything else, including out-of-range hours and minutes such as 25:99.
+func parseTimeFast(s string) (time.Time, bool) {
+	if len(s) < 5 || s[2] != ':' {
+		return time.Time{}, false
+	}
+	hour, ok1 := atoi(s[0:2])
+	minute, ok2 := atoi(s[3:5])
+	if !ok1 || !ok2 || hour > 23 || minute > 59 {
+		return time.Time{}, false
+	}
+	if rest := s[5:]; rest != "" {
+		if len(rest) < 3 || rest[0] != ':' {
+			return time.Time{}, false
+		}
+		second, ok := atoi(rest[1:3])
+		if !ok || second > 59 {
+			return time.Time{}, false
+		}
+		if frac := rest[3:]; frac != "" {
+			if _, ok := atoi(frac[1:]); frac[0] != '.' || len(frac) == 1 || !ok {
+				return time.Time{}, false
+			}
+		}
+	}
+	return time.Date(1, 1, 1, hour, minute, 0, 0, time.UTC), true
+}
+
+// parseRFC3339Fast parses a timestamp in the RFC3339 layout with optional
+// fractional seconds, without interpreting a layout string. It reports false
+// for anything it does not handle, in which case time.Parse should be used.
+// The result is in UTC, truncated to the second.
+func parseRFC3339Fast(s string) (time.Time, bool) {
+	// YYYY-MM-DDTHH:MM:SS followed by at least a "Z".
+	if len(s) < 20 || s[10] != 'T' || s[13] != ':' || s[16] != ':' {
+		return time.Time{}, false
+	}
+	date, ok := parseDateFast(s[:10])
+	if !ok {
+		return time.Time{}, false
+	}
+	hour, ok1 := atoi(s[11:13])
+	minute, ok2 := atoi(s[14:16])
+	second, ok3 := atoi(s[17:19])
+	if !ok1 || !ok2 || !ok3 || hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, false
+	}
+
+	rest := s[19:]
+	if rest[0] == '.' {
+		i := 1
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 1 || i > 10 {
+			return time.Time{}, false
+		}
+		rest = rest[i:]
+	}
+
+	offset := 0
+	switch {
+	case rest == "Z":
+	case len(rest) == 6 && (rest[0] == '+' || rest[0] == '-') && rest[3] == ':':
+		oh, ok1 := atoi(rest[1:3])
+		om, ok2 := atoi(rest[4:6])
+		if !ok1 || !ok2 || oh > 23 || om > 59 {
+			return time.Time{}, false
+		}
+		offset = (oh*60 + om) * 60
+		if rest[0] == '-' {
+			offset = -offset
+		}
+	default:
+		return time.Time{}, false
+	}
+
+	secs := date.Unix() + int64(hour*3600+minute*60+second-offset)
+	return time.Unix(secs, 0).UTC(), true
+}
+
+// appendRFC3339UTC appends t, which must be in UTC without fractional
+// seconds, in the RFC3339 layout: YYYY-MM-DDTHH:MM:SSZ. Years outside
+// 0000-9999 fall back to time.AppendFormat.
+func appendRFC3339UTC(b []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	if year < 0 || year > 9999 {
+		return t.AppendFormat(b, time.RFC3339)
+	}
+	b = appendYMD(b, year, month, day)
+	b = append(b, 'T')
+	b = appendClock(b, t)
+	b = append(b, ':')
+	b = appendInt(b, t.Second(), 2)
+	return append(b, 'Z')
+}
+
+// appendDate appends t's date in the YYYY-MM-DD layout. Years outside
+// 0000-9999 fall back to time.AppendFormat.
+func appendDate(b []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	if year < 0 || year > 9999 {
+		return t.AppendFormat(b, dateFormat)
+	}
+	return appendYMD(b, year, month, day)
+}
+
+func appendYMD(b []byte, year int, month time.Month, day int) []byte {
+	b = appendInt(b, year, 4)
+	b = append(b, '-')
+	b = appendInt(b, int(month), 2)
+	b = append(b, '-')
+	return appendInt(b, day, 2)
+}
+
+// appendClock appends t's time of day in the HH:MM layout.
+func appendClock(b []byte, t time.Time) []byte {
+	hour, minute, _ := t.Clock()
+	b = appendInt(b, hour, 2)
+	b = append(b, ':')
+	return appendInt(b, minute, 2)
+}
+
+// appendInt appends the non-negative n, zero-padded to width digits.
+func appendInt(b []byte, n, width int) []byte {
+	var buf [4]byte
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = byte('0' + n%10)
+		n /= 10
+	}
+	return append(b, buf[:width]...)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// protoDate is the JSON object form of google.type.Date.
+type protoDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// protoTimeOfDay is the JSON object form of google.type.TimeOfDay.
+type protoTimeOfDay struct {
+	Hours   int `json:"hours"`
+	Minutes int `json:"minutes"`
+	Seconds int `json:"seconds"`
+	Nanos   int `json:"nanos"`
+}
+
+// appendProtoDate appends t in google.type.Date object form.
+func appendProtoDate(b []byte, t time.Time) []byte {
+	y, m, d := t.Date()
+	b = append(b, `{"year":`...)
+	b = strconv.AppendInt(b, int64(y), 10)
+	b = append(b, `,"month":`...)
+	b = strconv.AppendInt(b, int64(m), 10)
+	b = append(b, `,"day":`...)
+	b = strconv.AppendInt(b, int64(d), 10)
+	return append(b, '}')
+}
+
+// appendProtoTimeOfDay appends the time of day of t in google.type.TimeOfDay object form.
+func appendProtoTimeOfDay(b []byte, t time.Time) []byte {
+	h, m, _ := t.Clock()
+	b = append(b, `{"hours":`...)
+	b = strconv.AppendInt(b, int64(h), 10)
+	b = append(b, `,"minutes":`...)
+	b = strconv.AppendInt(b, int64(m), 10)
+	return append(b, `,"seconds":0,"nanos":0}`...)
+}
+
+// parseProtoDate parses a google.type.Date JSON object. Partial dates with a
+// zero year, month or day are rejected.
+func parseProtoDate(data []byte) (time.Time, error) {
+	var pd protoDate
+	if err := json.Unmarshal(data, &pd); err != nil {
+		return time.Time{}, invalidFormat("Date", string(data), "google.type.Date object", err)
+	}
+	t := time.Date(pd.Year, time.Month(pd.Month), pd.Day, 0, 0, 0, 0, time.UTC)
+	if pd.Year < 1 || pd.Month < 1 || pd.Day < 1 || t.Day() != pd.Day || int(t.Month()) != pd.Month {
+		return time.Time{}, invalidFormat("Date", string(data), "google.type.Date object", fmt.Errorf("no such date %d-%d-%d", pd.Year, pd.Month, pd.Day))
+	}
+	return t, nil
+}
+
+// parseProtoTimeOfDay parses a google.type.TimeOfDay JSON object.
+func parseProtoTimeOfDay(data []byte) (time.Time, error) {
+	var pt protoTimeOfDay
+	if err := json.Unmarshal(data, &pt); err != nil {
+		return time.Time{}, invalidFormat("Time", string(data), "google.type.TimeOfDay object", err)
+	}
+	if pt.Hours < 0 || pt.Hours > 23 || pt.Minutes < 0 || pt.Minutes > 59 ||
+		pt.Seconds < 0 || pt.Seconds > 59 || pt.Nanos < 0 || pt.Nanos > 999_999_999 {
+		return time.Time{}, invalidFormat("Time", string(data), "google.type.TimeOfDay object", fmt.Errorf("no such time %02d:%02d:%02d", pt.Hours, pt.Minutes, pt.Seconds))
+	}
+	return time.Date(1, 1, 1, pt.Hours, pt.Minutes, 0, 0, time.UTC), nil
+}
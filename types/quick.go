@@ -0,0 +1,85 @@
+package types
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+	"unicode/utf8"
+)
+
+// quickNullOdds is the chance, one in quickNullOdds, that Generate returns an invalid value.
+const quickNullOdds = 10
+
+// Range of the dates and timestamps produced by Generate.
+var (
+	quickMinTime = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+	quickMaxTime = time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// quickTime returns a random UTC time between 1900 and 2100.
+func quickTime(r *rand.Rand) time.Time {
+	span := quickMaxTime.Unix() - quickMinTime.Unix()
+	return time.Unix(quickMinTime.Unix()+r.Int63n(span), 0).UTC()
+}
+
+// Generate implements the quick.Generator interface.
+// It returns a random Date between 1900 and 2100, or occasionally an invalid Date.
+func (Date) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(quickNullOdds) == 0 {
+		return reflect.ValueOf(Date{})
+	}
+	return reflect.ValueOf(NewDateIn(quickTime(r), time.UTC))
+}
+
+// Generate implements the quick.Generator interface.
+// It returns a random Time, or occasionally an invalid Time.
+func (Time) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(quickNullOdds) == 0 {
+		return reflect.ValueOf(Time{})
+	}
+	return reflect.ValueOf(NewTime(time.Time{}.Add(time.Duration(r.Intn(24*60)) * time.Minute)))
+}
+
+// Generate implements the quick.Generator interface.
+// It returns a random Timestamp between 1900 and 2100, or occasionally an invalid Timestamp.
+func (Timestamp) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(quickNullOdds) == 0 {
+		return reflect.ValueOf(Timestamp{})
+	}
+	return reflect.ValueOf(NewTimestamp(quickTime(r)))
+}
+
+// Generate implements the quick.Generator interface.
+// It returns a random String of up to size runes, mostly printable ASCII with
+// some other valid Unicode, or occasionally an invalid String.
+func (String) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(quickNullOdds) == 0 {
+		return reflect.ValueOf(String{})
+	}
+	n := r.Intn(size + 1)
+	b := make([]byte, 0, n)
+	for range n {
+		var c rune
+		if r.Intn(8) == 0 {
+			// Any valid code point, excluding the surrogate range.
+			for c = rune(r.Intn(utf8.MaxRune + 1)); !utf8.ValidRune(c); c = rune(r.Intn(utf8.MaxRune + 1)) {
+			}
+		} else {
+			c = rune(' ' + r.Intn('~'-' '+1))
+		}
+		b = utf8.AppendRune(b, c)
+	}
+	return reflect.ValueOf(NewString(string(b)))
+}
+
+// Generate implements the quick.Generator interface, see Date.Generate.
+func (CompactDate) Generate(r *rand.Rand, size int) reflect.Value {
+	d := Date{}.Generate(r, size).Interface().(Date)
+	return reflect.ValueOf(d.Compact())
+}
+
+// Generate implements the quick.Generator interface, see Time.Generate.
+func (CompactTime) Generate(r *rand.Rand, size int) reflect.Value {
+	t := Time{}.Generate(r, size).Interface().(Time)
+	return reflect.ValueOf(t.Compact())
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// jsonBufSize is the capacity MarshalJSON allocates for the Date, Time and
+// Timestamp encodings, so that the result is built in a single allocation.
+const jsonBufSize = 32
+
+// appendJSONString appends s to b as a quoted JSON string. All JSON strings
+// with arbitrary content go through it, so escaping is consistent across the
+// package's encoders. The output is identical to encoding/json's: <, > and &
+// are escaped for safe embedding in HTML, as are U+2028 and U+2029, and
+// invalid UTF-8 is replaced with U+FFFD.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are valid JSON but break JavaScript string literals.
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// unquoteJSONStrict decodes data, which must be a JSON string, as used by
+// UnmarshalJSON with SetStrictJSON enabled. typ names the type in errors.
+func unquoteJSONStrict(data []byte, typ string) (string, error) {
+	if len(data) == 0 || data[0] != '"' {
+		return "", invalidFormat(typ, string(data), "JSON string", nil)
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", invalidFormat(typ, string(data), "JSON string", err)
+	}
+	return s, nil
+}
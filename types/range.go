@@ -0,0 +1,438 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RangeBound is the kind of bound at either end of a range.
+type RangeBound uint8
+
+const (
+	// Inclusive bounds, written [ and ], include their value in the range.
+	Inclusive RangeBound = iota
+	// Exclusive bounds, written ( and ), exclude their value from the range.
+	Exclusive
+	// Unbounded ends extend the range indefinitely. Their value is ignored.
+	Unbounded
+)
+
+// errRangeOrder is wrapped when the lower bound of a range exceeds its upper bound.
+var errRangeOrder = errors.New("lower bound must be less than or equal to upper bound")
+
+// Int64Range is a nullable range of integers, as stored in PostgreSQL int4range
+// and int8range columns, e.g. for pricing tiers or quota windows. It is stored
+// in PostgreSQL's text form, such as [1,10), and encoded in JSON as an object,
+// such as {"lower":1,"upper":10,"bounds":"[)"}, with null for an unbounded end.
+//
+// Like PostgreSQL, parsing and NewInt64Range yield canonical ranges: lower
+// bound inclusive, upper bound exclusive, and empty ranges as [0,0), written
+// "empty". Other forms may be assembled by hand; Equal, Contains and Overlaps
+// compare ranges by the integers they contain.
+type Int64Range struct {
+	Lower, Upper           int64
+	LowerBound, UpperBound RangeBound
+	Valid                  bool
+}
+
+// NewInt64Range creates a new valid Int64Range from lower, inclusive, to upper,
+// exclusive. The range is empty if upper is not greater than lower.
+func NewInt64Range(lower, upper int64) Int64Range {
+	if upper <= lower {
+		return Int64Range{UpperBound: Exclusive, Valid: true}
+	}
+	return Int64Range{Lower: lower, Upper: upper, UpperBound: Exclusive, Valid: true}
+}
+
+// ParseInt64Range parses a range in PostgreSQL's text form, such as "[1,10]",
+// "(,5)" or "empty", into its canonical form. An empty string yields an
+// invalid Int64Range.
+func ParseInt64Range(s string) (Int64Range, error) {
+	var r Int64Range
+	err := r.parseRange(s)
+	return r, err
+}
+
+// NullInt64Range returns an invalid (NULL) Int64Range. It is equivalent to Int64Range{}.
+func NullInt64Range() Int64Range {
+	return Int64Range{}
+}
+
+// parseRange parses s into the Int64Range, marking it invalid if s is empty.
+func (r *Int64Range) parseRange(s string) error {
+	if s == "" {
+		*r = Int64Range{}
+		return nil
+	}
+	text := strings.TrimSpace(s)
+	if strings.EqualFold(text, "empty") {
+		*r = Int64Range{UpperBound: Exclusive, Valid: true}
+		return nil
+	}
+	if len(text) < 3 || !strings.ContainsRune("[(", rune(text[0])) || !strings.ContainsRune("])", rune(text[len(text)-1])) {
+		return invalidFormat("Int64Range", s, "range literal", nil)
+	}
+	lower, upper, ok := strings.Cut(text[1:len(text)-1], ",")
+	if !ok {
+		return invalidFormat("Int64Range", s, "range literal", errors.New("missing comma"))
+	}
+	out := Int64Range{Valid: true}
+	if text[0] == '(' {
+		out.LowerBound = Exclusive
+	}
+	if text[len(text)-1] == ')' {
+		out.UpperBound = Exclusive
+	}
+	var err error
+	if out.Lower, out.LowerBound, err = parseRangeBound(lower, out.LowerBound); err != nil {
+		return invalidFormat("Int64Range", s, "range literal", err)
+	}
+	if out.Upper, out.UpperBound, err = parseRangeBound(upper, out.UpperBound); err != nil {
+		return invalidFormat("Int64Range", s, "range literal", err)
+	}
+	if out, err = out.canonical(); err != nil {
+		return invalidFormat("Int64Range", s, "range literal", err)
+	}
+	*r = out
+	return nil
+}
+
+// parseRangeBound parses one end of a range literal, which is unbounded if empty.
+func parseRangeBound(s string, bound RangeBound) (int64, RangeBound, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	if s == "" {
+		return 0, Unbounded, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	return n, bound, err
+}
+
+// canonical returns the range with an inclusive lower and exclusive upper
+// bound, as PostgreSQL stores discrete ranges. Unbounded ends are zeroed, and
+// empty ranges become [0,0).
+func (r Int64Range) canonical() (Int64Range, error) {
+	if r.LowerBound > Unbounded || r.UpperBound > Unbounded {
+		return Int64Range{}, errors.New("unknown bound")
+	}
+	if r.LowerBound != Unbounded && r.UpperBound != Unbounded && r.Lower > r.Upper {
+		return Int64Range{}, errRangeOrder
+	}
+	c := r
+	switch c.LowerBound {
+	case Exclusive:
+		if c.Lower == math.MaxInt64 {
+			return Int64Range{}, errors.New("lower bound out of range")
+		}
+		c.Lower, c.LowerBound = c.Lower+1, Inclusive
+	case Unbounded:
+		c.Lower = 0
+	}
+	switch c.UpperBound {
+	case Inclusive:
+		if c.Upper == math.MaxInt64 {
+			return Int64Range{}, errors.New("upper bound out of range")
+		}
+		c.Upper, c.UpperBound = c.Upper+1, Exclusive
+	case Unbounded:
+		c.Upper = 0
+	}
+	if c.LowerBound != Unbounded && c.UpperBound != Unbounded && c.Lower >= c.Upper {
+		return Int64Range{UpperBound: Exclusive, Valid: r.Valid}, nil
+	}
+	return c, nil
+}
+
+// IsEmpty reports whether the Int64Range is valid but contains no integers.
+func (r Int64Range) IsEmpty() bool {
+	c, err := r.canonical()
+	return r.Valid && err == nil && c.isEmpty()
+}
+
+// isEmpty reports whether the canonical range c is empty.
+func (c Int64Range) isEmpty() bool {
+	return c.LowerBound == Inclusive && c.UpperBound == Exclusive && c.Lower == c.Upper
+}
+
+// Contains reports whether the Int64Range is valid and contains v.
+func (r Int64Range) Contains(v int64) bool {
+	c, err := r.canonical()
+	if !r.Valid || err != nil || c.isEmpty() {
+		return false
+	}
+	return (c.LowerBound == Unbounded || v >= c.Lower) && (c.UpperBound == Unbounded || v < c.Upper)
+}
+
+// Overlaps reports whether r and other are valid and have an integer in common.
+func (r Int64Range) Overlaps(other Int64Range) bool {
+	a, aerr := r.canonical()
+	b, berr := other.canonical()
+	if !r.Valid || !other.Valid || aerr != nil || berr != nil || a.isEmpty() || b.isEmpty() {
+		return false
+	}
+	return (a.LowerBound == Unbounded || b.UpperBound == Unbounded || a.Lower < b.Upper) &&
+		(b.LowerBound == Unbounded || a.UpperBound == Unbounded || b.Lower < a.Upper)
+}
+
+// appendRange appends the canonical text form of the range to b.
+func (r Int64Range) appendRange(b []byte) []byte {
+	c, err := r.canonical()
+	if err != nil {
+		// Not representable; written as assembled so that the database rejects it.
+		c = r
+	}
+	if c.isEmpty() {
+		return append(b, "empty"...)
+	}
+	if c.LowerBound == Inclusive {
+		b = append(b, '[')
+	} else {
+		b = append(b, '(')
+	}
+	if c.LowerBound != Unbounded {
+		b = strconv.AppendInt(b, c.Lower, 10)
+	}
+	b = append(b, ',')
+	if c.UpperBound != Unbounded {
+		b = strconv.AppendInt(b, c.Upper, 10)
+	}
+	if c.UpperBound == Inclusive {
+		return append(b, ']')
+	}
+	return append(b, ')')
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL int4range or int8range in text form, such as [1,10),
+// handling NULL. sql.RawBytes is accepted too.
+func (r *Int64Range) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*r = Int64Range{}
+		return nil
+	case string:
+		return r.parseRange(v)
+	case []byte:
+		return r.parseRange(string(v))
+	default:
+		return unsupportedScanType("Int64Range", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the range in canonical text form, or nil if invalid.
+func (r Int64Range) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// int64RangeJSON is the JSON object form of an Int64Range.
+type int64RangeJSON struct {
+	Lower  *int64 `json:"lower"`
+	Upper  *int64 `json:"upper"`
+	Bounds string `json:"bounds"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the range as a JSON object in canonical form, or null if invalid.
+func (r Int64Range) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(make([]byte, 0, 64)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Int64Range to b, as returned by MarshalJSON.
+func (r Int64Range) AppendJSON(b []byte) []byte {
+	if !r.Valid {
+		return appendNullJSON(b, zeroRangeJSON)
+	}
+	c, err := r.canonical()
+	if err != nil {
+		c = r
+	}
+	b = append(b, `{"lower":`...)
+	if c.LowerBound == Unbounded {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, c.Lower, 10)
+	}
+	b = append(b, `,"upper":`...)
+	if c.UpperBound == Unbounded {
+		b = append(b, "null"...)
+	} else {
+		b = strconv.AppendInt(b, c.Upper, 10)
+	}
+	b = append(b, `,"bounds":"`...)
+	if c.LowerBound == Inclusive {
+		b = append(b, '[')
+	} else {
+		b = append(b, '(')
+	}
+	if c.UpperBound == Inclusive {
+		b = append(b, ']')
+	} else {
+		b = append(b, ')')
+	}
+	return append(b, `"}`...)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON object as produced by MarshalJSON, handling null. A missing
+// bounds member means "[)", and a null or missing lower or upper member an
+// unbounded end. A JSON string in PostgreSQL's text form is accepted too.
+func (r *Int64Range) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = Int64Range{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return invalidFormat("Int64Range", string(data), "JSON object", err)
+		}
+		return r.parseRange(s)
+	}
+	var v int64RangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return invalidFormat("Int64Range", string(data), "JSON object", err)
+	}
+	if v.Bounds == "" {
+		v.Bounds = "[)"
+	}
+	if len(v.Bounds) != 2 || !strings.ContainsRune("[(", rune(v.Bounds[0])) || !strings.ContainsRune("])", rune(v.Bounds[1])) {
+		return invalidFormat("Int64Range", string(data), "JSON object", fmt.Errorf("invalid bounds %q", v.Bounds))
+	}
+	out := Int64Range{Valid: true}
+	switch {
+	case v.Lower == nil:
+		out.LowerBound = Unbounded
+	case v.Bounds[0] == '(':
+		out.Lower, out.LowerBound = *v.Lower, Exclusive
+	default:
+		out.Lower = *v.Lower
+	}
+	switch {
+	case v.Upper == nil:
+		out.UpperBound = Unbounded
+	case v.Bounds[1] == ')':
+		out.Upper, out.UpperBound = *v.Upper, Exclusive
+	default:
+		out.Upper = *v.Upper
+	}
+	out, err := out.canonical()
+	if err != nil {
+		return invalidFormat("Int64Range", string(data), "JSON object", err)
+	}
+	*r = out
+	return nil
+}
+
+// IsZero reports whether the Int64Range is invalid.
+func (r Int64Range) IsZero() bool {
+	return !r.Valid
+}
+
+// IsNull reports whether the Int64Range is invalid (NULL).
+func (r Int64Range) IsNull() bool {
+	return !r.Valid
+}
+
+// HasValue reports whether the Int64Range is valid (non-NULL). It is the negation of IsNull.
+func (r Int64Range) HasValue() bool {
+	return r.Valid
+}
+
+// Equal reports whether r and other contain the same integers, or are both invalid.
+func (r Int64Range) Equal(other Int64Range) bool {
+	if !r.Valid || !other.Valid {
+		return r.Valid == other.Valid
+	}
+	a, aerr := r.canonical()
+	b, berr := other.canonical()
+	if aerr != nil || berr != nil {
+		return r == other
+	}
+	return a == b
+}
+
+// Validate returns an *ErrInvalidFormat if the Int64Range is valid but has an
+// unknown bound, a lower bound above its upper bound, or an end that cannot be
+// made canonical without overflowing.
+func (r Int64Range) Validate() error {
+	if !r.Valid {
+		return nil
+	}
+	if _, err := r.canonical(); err != nil {
+		return invalidFormat("Int64Range", string(r.appendRange(nil)), "range literal", err)
+	}
+	return nil
+}
+
+// String returns the range in canonical text form, such as [1,10) or empty,
+// or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (r Int64Range) String() string {
+	if !r.Valid {
+		return ""
+	}
+	return string(r.appendRange(make([]byte, 0, 24)))
+}
+
+// Set implements the flag.Value interface.
+// It parses a range literal into the Int64Range, marking it invalid if the string is empty.
+func (r *Int64Range) Set(s string) error {
+	return r.parseRange(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (r *Int64Range) Type() string {
+	return "int64range"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Int64Range is encoded as empty text.
+func (r Int64Range) MarshalText() ([]byte, error) {
+	return r.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the range as returned by String to b.
+func (r Int64Range) AppendText(b []byte) ([]byte, error) {
+	if !r.Valid {
+		return b, nil
+	}
+	return r.appendRange(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the Int64Range invalid.
+func (r *Int64Range) UnmarshalText(text []byte) error {
+	return r.parseRange(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into an Int64Range.
+func (r *Int64Range) UnmarshalParam(param string) error {
+	return r.parseRange(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the range in text form, or nil if invalid.
+func (r Int64Range) LogValue() slog.Value {
+	if !r.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(r.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (r Int64Range) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Int64Range", "Range", r.String(), r.Valid)
+}
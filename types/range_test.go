@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func TestParseInt64Range(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // canonical String of the parsed range
+	}{
+		{"[1,10)", "[1,10)"},
+		{"[1,10]", "[1,11)"},
+		{"(1,10)", "[2,10)"},
+		{"(1,10]", "[2,11)"},
+		{" [ -5 , 5 ) ", "[-5,5)"},
+		{`["1","3"]`, "[1,4)"},
+		{"(,5)", "(,5)"},
+		{"[,5]", "(,6)"},
+		{"[5,)", "[5,)"},
+		{"(,)", "(,)"},
+		{"empty", "empty"},
+		{"EMPTY", "empty"},
+		{"[3,3)", "empty"},
+		{"(3,3]", "empty"},
+		{"(3,4)", "empty"},
+		{"[3,3]", "[3,4)"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			r, err := types.ParseInt64Range(tt.in)
+			if err != nil {
+				t.Fatalf("ParseInt64Range(%q): %v", tt.in, err)
+			}
+			if r.Valid != (tt.in != "") {
+				t.Errorf("ParseInt64Range(%q).Valid = %v", tt.in, r.Valid)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("ParseInt64Range(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInt64RangeErrors(t *testing.T) {
+	for _, in := range []string{
+		"1,10",
+		"[1,10",
+		"[1;10)",
+		"[a,10)",
+		"[10,1)",
+		"(9223372036854775807,)",
+		"[,9223372036854775807]",
+		"[]",
+	} {
+		t.Run(in, func(t *testing.T) {
+			r, err := types.ParseInt64Range(in)
+			if err == nil {
+				t.Fatalf("ParseInt64Range(%q) = %v, want error", in, r)
+			}
+			if !errors.Is(err, &types.ErrInvalidFormat{Type: "Int64Range"}) {
+				t.Errorf("ParseInt64Range(%q) error %v is not an Int64Range ErrInvalidFormat", in, err)
+			}
+		})
+	}
+}
+
+func TestInt64RangeContains(t *testing.T) {
+	tests := []struct {
+		r    string
+		v    int64
+		want bool
+	}{
+		{"[1,10)", 1, true},
+		{"[1,10)", 9, true},
+		{"[1,10)", 10, false},
+		{"[1,10)", 0, false},
+		{"(1,10]", 1, false},
+		{"(1,10]", 10, true},
+		{"(,5)", -1 << 63, true},
+		{"(,5)", 5, false},
+		{"[5,)", 1<<63 - 1, true},
+		{"(,)", 0, true},
+		{"empty", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		r, err := types.ParseInt64Range(tt.r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := r.Contains(tt.v); got != tt.want {
+			t.Errorf("%q.Contains(%d) = %v, want %v", tt.r, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestInt64RangeOverlaps(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"[1,5)", "[5,10)", false},
+		{"[1,5]", "[5,10)", true},
+		{"[1,5)", "(4,10)", false},
+		{"[1,5]", "(5,10)", false},
+		{"[1,10)", "[3,4)", true},
+		{"(,5)", "[4,)", true},
+		{"(,5)", "[5,)", false},
+		{"(,)", "[100,101)", true},
+		{"(,)", "(,)", true},
+		{"empty", "(,)", false},
+		{"empty", "empty", false},
+		{"", "(,)", false},
+	}
+	for _, tt := range tests {
+		a, err := types.ParseInt64Range(tt.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := types.ParseInt64Range(tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := a.Overlaps(b); got != tt.want {
+			t.Errorf("%q.Overlaps(%q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := b.Overlaps(a); got != tt.want {
+			t.Errorf("%q.Overlaps(%q) = %v, want %v", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestInt64RangeHandAssembled(t *testing.T) {
+	// Non-canonical ranges compare by the integers they contain.
+	r := types.Int64Range{Lower: 0, Upper: 9, LowerBound: types.Exclusive, UpperBound: types.Inclusive, Valid: true}
+	if want := types.NewInt64Range(1, 10); !r.Equal(want) {
+		t.Errorf("%v.Equal(%v) = false, want true", r, want)
+	}
+	if !r.Contains(9) || r.Contains(0) {
+		t.Errorf("%v.Contains is wrong at the bounds", r)
+	}
+	empty := types.Int64Range{Lower: 4, Upper: 5, LowerBound: types.Exclusive, UpperBound: types.Exclusive, Valid: true}
+	if !empty.IsEmpty() || empty.String() != "empty" {
+		t.Errorf("%v.IsEmpty() = %v, want true", empty, empty.IsEmpty())
+	}
+	if types.NewInt64Range(5, 1).String() != "empty" {
+		t.Errorf("NewInt64Range(5, 1) = %v, want empty", types.NewInt64Range(5, 1))
+	}
+	if reversed := (types.Int64Range{Lower: 5, Upper: 1, UpperBound: types.Exclusive, Valid: true}); reversed.Validate() == nil {
+		t.Errorf("%v.Validate() = nil, want error", reversed)
+	}
+}
+
+func TestInt64RangeJSON(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`{"lower":1,"upper":10,"bounds":"[]"}`, `{"lower":1,"upper":11,"bounds":"[)"}`},
+		{`{"lower":1,"upper":10}`, `{"lower":1,"upper":10,"bounds":"[)"}`},
+		{`{"lower":null,"upper":10,"bounds":"(]"}`, `{"lower":null,"upper":11,"bounds":"()"}`},
+		{`{"bounds":"()"}`, `{"lower":null,"upper":null,"bounds":"()"}`},
+		{`{"lower":3,"upper":3}`, `{"lower":0,"upper":0,"bounds":"[)"}`},
+		{`"[1,10]"`, `{"lower":1,"upper":11,"bounds":"[)"}`},
+		{`null`, `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var r types.Int64Range
+			if err := json.Unmarshal([]byte(tt.in), &r); err != nil {
+				t.Fatal(err)
+			}
+			out, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(out) != tt.want {
+				t.Errorf("Marshal(Unmarshal(%s)) = %s, want %s", tt.in, out, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{`{"lower":10,"upper":1}`, `{"bounds":"[["}`, `{"lower":"a"}`, `[1,10]`} {
+		var r types.Int64Range
+		if err := json.Unmarshal([]byte(in), &r); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want error", in, r)
+		}
+	}
+}
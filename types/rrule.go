@@ -0,0 +1,668 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of an RRule.
+type Frequency int
+
+const (
+	// FreqMinutely repeats every INTERVAL minutes.
+	FreqMinutely Frequency = iota + 1
+	// FreqHourly repeats every INTERVAL hours.
+	FreqHourly
+	// FreqDaily repeats every INTERVAL days.
+	FreqDaily
+	// FreqWeekly repeats every INTERVAL weeks.
+	FreqWeekly
+	// FreqMonthly repeats every INTERVAL months.
+	FreqMonthly
+	// FreqYearly repeats every INTERVAL years.
+	FreqYearly
+)
+
+var frequencyNames = [...]string{
+	FreqMinutely: "MINUTELY",
+	FreqHourly:   "HOURLY",
+	FreqDaily:    "DAILY",
+	FreqWeekly:   "WEEKLY",
+	FreqMonthly:  "MONTHLY",
+	FreqYearly:   "YEARLY",
+}
+
+// String returns the iCalendar name of the frequency, e.g. "WEEKLY".
+func (f Frequency) String() string {
+	if f <= 0 || int(f) >= len(frequencyNames) {
+		return "unknown"
+	}
+	return frequencyNames[f]
+}
+
+// WeekdayNum is an entry of BYDAY: a weekday, optionally with an ordinal such
+// as 2 for "second Monday" (2MO) or -1 for "last Friday" (-1FR).
+type WeekdayNum struct {
+	// N is the ordinal within the month or year, or 0 for every such weekday.
+	N       int
+	Weekday time.Weekday
+}
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// String returns the iCalendar form of the entry, e.g. "MO" or "-1FR".
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayCodes[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+}
+
+// RRule is a nullable iCalendar recurrence rule as defined by RFC 5545, section 3.3.10.
+// It is stored in the database and encoded in JSON as its string form,
+// e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE".
+//
+// The rule parts FREQ (MINUTELY to YEARLY), INTERVAL, COUNT, UNTIL, BYMONTH,
+// BYMONTHDAY, BYDAY, BYHOUR, BYMINUTE and WKST are supported. Rules using
+// SECONDLY, BYSECOND, BYYEARDAY, BYWEEKNO or BYSETPOS are rejected.
+type RRule struct {
+	Freq Frequency
+
+	// Interval is the number of periods between repetitions; 0 is treated as 1.
+	Interval int
+
+	// Count limits the number of occurrences, or 0 for no limit.
+	Count int
+
+	// Until is the last possible occurrence in UTC, or the zero time for no limit.
+	Until time.Time
+
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+	ByHour     []int
+	ByMinute   []int
+
+	// WeekStart is the first day of the week, used by weekly rules with an interval.
+	// ParseRRule sets it to time.Monday if WKST is absent, as RFC 5545 specifies.
+	WeekStart time.Weekday
+
+	Valid bool
+}
+
+// untilFormat is the UTC DATE-TIME form of UNTIL.
+const untilFormat = "20060102T150405Z"
+
+// ParseRRule parses an RRULE value such as "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6".
+// A leading "RRULE:" is accepted. An empty string yields an invalid RRule.
+func ParseRRule(s string) (RRule, error) {
+	var r RRule
+	if err := r.parseRRuleString(s); err != nil {
+		return RRule{}, err
+	}
+	return r, nil
+}
+
+// NullRRule returns an invalid (NULL) RRule. It is equivalent to RRule{}.
+func NullRRule() RRule {
+	return RRule{}
+}
+
+// parseRRuleString parses s into r, marking r invalid if s is empty.
+func (r *RRule) parseRRuleString(s string) error {
+	*r = RRule{}
+	if err := r.parseRRuleParts(strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")); err != nil {
+		return invalidFormat("RRule", s, "RFC 5545 RRULE", err)
+	}
+	return nil
+}
+
+// parseRRuleParts parses the ;-separated parts of an RRULE into r, leaving r
+// unchanged on error or if s is empty.
+func (r *RRule) parseRRuleParts(s string) error {
+	if s == "" {
+		return nil
+	}
+
+	rule := RRule{WeekStart: time.Monday, Valid: true}
+	seen := make(map[string]bool)
+	for part := range strings.SplitSeq(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return fmt.Errorf("invalid RRULE part %q", strings.Clone(part))
+		}
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return fmt.Errorf("duplicate RRULE part %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(value)
+		case "INTERVAL":
+			rule.Interval, err = parseRRuleInt(name, value, 1, 0)
+		case "COUNT":
+			rule.Count, err = parseRRuleInt(name, value, 1, 0)
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		case "BYMONTH":
+			var months []int
+			months, err = parseRRuleList(name, value, 1, 12, false)
+			for _, m := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(m))
+			}
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRRuleList(name, value, 1, 31, true)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		case "BYHOUR":
+			rule.ByHour, err = parseRRuleList(name, value, 0, 23, false)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseRRuleList(name, value, 0, 59, false)
+		case "WKST":
+			var wd WeekdayNum
+			if wd, err = parseWeekdayNum(value); err == nil && wd.N != 0 {
+				err = fmt.Errorf("invalid RRULE WKST %q", strings.Clone(value))
+			}
+			rule.WeekStart = wd.Weekday
+		default:
+			return fmt.Errorf("unsupported RRULE part %s", name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return err
+	}
+	*r = rule
+	return nil
+}
+
+// validate checks the constraints RFC 5545 places between rule parts.
+func (r RRule) validate() error {
+	switch {
+	case r.Freq == 0:
+		return fmt.Errorf("RRULE requires FREQ")
+	case r.Count != 0 && !r.Until.IsZero():
+		return fmt.Errorf("RRULE must not contain both COUNT and UNTIL")
+	case r.Freq == FreqWeekly && len(r.ByMonthDay) > 0:
+		return fmt.Errorf("BYMONTHDAY must not be used with FREQ=WEEKLY")
+	}
+	for _, wd := range r.ByDay {
+		if wd.N != 0 && r.Freq != FreqMonthly && r.Freq != FreqYearly {
+			return fmt.Errorf("BYDAY ordinal %s requires FREQ=MONTHLY or FREQ=YEARLY", wd)
+		}
+	}
+	return nil
+}
+
+func parseFrequency(s string) (Frequency, error) {
+	for f, name := range frequencyNames {
+		if name != "" && strings.EqualFold(name, s) {
+			return Frequency(f), nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported RRULE FREQ %q", strings.Clone(s))
+}
+
+// parseRRuleInt parses an integer in [min, max], or at least min if max is 0.
+func parseRRuleInt(name, s string, min, max int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < min || (max != 0 && n > max) {
+		return 0, fmt.Errorf("invalid RRULE %s %q", name, strings.Clone(s))
+	}
+	return n, nil
+}
+
+// parseRRuleList parses a comma-separated list of integers in [min, max] into a sorted slice.
+// With signed set, values in [-max, -min] are accepted too.
+func parseRRuleList(name, s string, min, max int, signed bool) ([]int, error) {
+	var list []int
+	for item := range strings.SplitSeq(s, ",") {
+		n, err := strconv.Atoi(item)
+		abs := n
+		if signed && n < 0 {
+			abs = -n
+		}
+		if err != nil || abs < min || abs > max {
+			return nil, fmt.Errorf("invalid RRULE %s %q", name, strings.Clone(item))
+		}
+		list = append(list, n)
+	}
+	slices.Sort(list)
+	return list, nil
+}
+
+func parseByDay(s string) ([]WeekdayNum, error) {
+	var list []WeekdayNum
+	for item := range strings.SplitSeq(s, ",") {
+		wd, err := parseWeekdayNum(item)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, wd)
+	}
+	return list, nil
+}
+
+// parseWeekdayNum parses a BYDAY entry such as "MO", "+2TU" or "-1FR".
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	if len(s) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid RRULE weekday %q", strings.Clone(s))
+	}
+	code := strings.ToUpper(s[len(s)-2:])
+	wd := slices.Index(weekdayCodes[:], code)
+	if wd < 0 {
+		return WeekdayNum{}, fmt.Errorf("invalid RRULE weekday %q", strings.Clone(s))
+	}
+	var n int
+	if prefix := s[:len(s)-2]; prefix != "" {
+		var err error
+		n, err = strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -53 || n > 53 {
+			return WeekdayNum{}, fmt.Errorf("invalid RRULE weekday %q", strings.Clone(s))
+		}
+	}
+	return WeekdayNum{N: n, Weekday: time.Weekday(wd)}, nil
+}
+
+// parseUntil parses UNTIL as a UTC DATE-TIME, a floating DATE-TIME
+// (interpreted as UTC) or a DATE (the end of that day).
+func parseUntil(s string) (time.Time, error) {
+	for _, layout := range []string{untilFormat, "20060102T150405"} {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation("20060102", s, time.UTC); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid RRULE UNTIL %q", strings.Clone(s))
+}
+
+// String returns the rule in RRULE form with the parts in a fixed order,
+// or an empty string if invalid.
+func (r RRule) String() string {
+	if !r.Valid {
+		return ""
+	}
+	b := make([]byte, 0, 64)
+	b = append(b, "FREQ="...)
+	b = append(b, r.Freq.String()...)
+	if r.Interval > 1 {
+		b = append(b, ";INTERVAL="...)
+		b = strconv.AppendInt(b, int64(r.Interval), 10)
+	}
+	if r.Count > 0 {
+		b = append(b, ";COUNT="...)
+		b = strconv.AppendInt(b, int64(r.Count), 10)
+	}
+	if !r.Until.IsZero() {
+		b = append(b, ";UNTIL="...)
+		b = r.Until.UTC().AppendFormat(b, untilFormat)
+	}
+	b = appendRRuleList(b, "BYMONTH", r.ByMonth, func(b []byte, m time.Month) []byte {
+		return strconv.AppendInt(b, int64(m), 10)
+	})
+	b = appendRRuleList(b, "BYMONTHDAY", r.ByMonthDay, appendRRuleInt)
+	b = appendRRuleList(b, "BYDAY", r.ByDay, func(b []byte, wd WeekdayNum) []byte {
+		return append(b, wd.String()...)
+	})
+	b = appendRRuleList(b, "BYHOUR", r.ByHour, appendRRuleInt)
+	b = appendRRuleList(b, "BYMINUTE", r.ByMinute, appendRRuleInt)
+	if r.WeekStart != time.Monday {
+		b = append(b, ";WKST="...)
+		b = append(b, weekdayCodes[r.WeekStart]...)
+	}
+	return string(b)
+}
+
+func appendRRuleInt(b []byte, n int) []byte {
+	return strconv.AppendInt(b, int64(n), 10)
+}
+
+// appendRRuleList appends ";NAME=a,b,c" to b, or nothing if list is empty.
+func appendRRuleList[T any](b []byte, name string, list []T, appendItem func([]byte, T) []byte) []byte {
+	if len(list) == 0 {
+		return b
+	}
+	b = append(b, ';')
+	b = append(b, name...)
+	b = append(b, '=')
+	for i, v := range list {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendItem(b, v)
+	}
+	return b
+}
+
+// Between returns the occurrences of the rule for a series starting at dtstart
+// that fall within [after, before], in ascending order. The rule is evaluated
+// in loc, which matters for rules with a time of day across DST changes;
+// a nil loc means UTC.
+//
+// Only instants matching the rule are returned: dtstart itself is included
+// only if it matches. COUNT is applied from dtstart, so occurrences before
+// after still count towards it. An invalid rule or invalid bounds yield nil.
+func (r RRule) Between(dtstart, after, before Timestamp, loc *time.Location) []Timestamp {
+	if !r.Valid || !dtstart.Valid || !after.Valid || !before.Valid {
+		return nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := dtstart.Time.In(loc)
+	end := before.Time
+	if !r.Until.IsZero() && r.Until.Before(end) {
+		end = r.Until
+	}
+
+	interval := max(r.Interval, 1)
+	byMonth, byMonthDay, byDay := r.effectiveDayFilters(start)
+	byHour, byMinute := r.ByHour, r.ByMinute
+
+	var out []Timestamp
+	count := 0
+	for period := r.periodStart(start); !period.After(end); period = r.nextPeriod(period, interval) {
+		for _, t := range r.candidates(period, start, byMonth, byMonthDay, byDay, byHour, byMinute) {
+			if t.Before(start) {
+				continue
+			}
+			if t.After(end) {
+				return out
+			}
+			count++
+			if !t.Before(after.Time) {
+				out = append(out, NewTimestamp(t))
+			}
+			if r.Count > 0 && count >= r.Count {
+				return out
+			}
+		}
+	}
+	return out
+}
+
+// effectiveDayFilters applies the defaults RFC 5545 derives from DTSTART when
+// a rule does not say which days it repeats on.
+func (r RRule) effectiveDayFilters(start time.Time) ([]time.Month, []int, []WeekdayNum) {
+	byMonth, byMonthDay, byDay := r.ByMonth, r.ByMonthDay, r.ByDay
+	switch r.Freq {
+	case FreqYearly:
+		if len(byMonthDay) == 0 && len(byDay) == 0 {
+			if len(byMonth) == 0 {
+				byMonth = []time.Month{start.Month()}
+			}
+			byMonthDay = []int{start.Day()}
+		}
+	case FreqMonthly:
+		if len(byMonthDay) == 0 && len(byDay) == 0 {
+			byMonthDay = []int{start.Day()}
+		}
+	case FreqWeekly:
+		if len(byDay) == 0 {
+			byDay = []WeekdayNum{{Weekday: start.Weekday()}}
+		}
+	}
+	return byMonth, byMonthDay, byDay
+}
+
+// periodStart returns the start of the period containing t.
+func (r RRule) periodStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	loc := t.Location()
+	switch r.Freq {
+	case FreqMinutely:
+		return time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, loc)
+	case FreqHourly:
+		return time.Date(y, m, d, t.Hour(), 0, 0, 0, loc)
+	case FreqDaily:
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	case FreqWeekly:
+		back := (int(t.Weekday()) - int(r.WeekStart) + 7) % 7
+		return time.Date(y, m, d-back, 0, 0, 0, 0, loc)
+	case FreqMonthly:
+		return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// nextPeriod returns the start of the period interval periods after p.
+func (r RRule) nextPeriod(p time.Time, interval int) time.Time {
+	y, m, d := p.Date()
+	switch r.Freq {
+	case FreqMinutely:
+		return p.Add(time.Duration(interval) * time.Minute)
+	case FreqHourly:
+		return p.Add(time.Duration(interval) * time.Hour)
+	case FreqDaily:
+		return time.Date(y, m, d+interval, 0, 0, 0, 0, p.Location())
+	case FreqWeekly:
+		return time.Date(y, m, d+7*interval, 0, 0, 0, 0, p.Location())
+	case FreqMonthly:
+		return time.Date(y, m+time.Month(interval), 1, 0, 0, 0, 0, p.Location())
+	default:
+		return time.Date(y+interval, time.January, 1, 0, 0, 0, 0, p.Location())
+	}
+}
+
+// candidates returns the instants in the period starting at period that match
+// the rule, in ascending order.
+func (r RRule) candidates(period, start time.Time, byMonth []time.Month, byMonthDay []int, byDay []WeekdayNum, byHour, byMinute []int) []time.Time {
+	loc := period.Location()
+	sec := start.Second()
+
+	switch r.Freq {
+	case FreqMinutely, FreqHourly:
+		if !r.matchesDay(period, byMonth, byMonthDay, byDay) || !containsOrEmpty(byHour, period.Hour()) {
+			return nil
+		}
+		y, m, d := period.Date()
+		if r.Freq == FreqMinutely {
+			if !containsOrEmpty(byMinute, period.Minute()) {
+				return nil
+			}
+			return []time.Time{time.Date(y, m, d, period.Hour(), period.Minute(), sec, 0, loc)}
+		}
+		minutes := orDefault(byMinute, start.Minute())
+		out := make([]time.Time, 0, len(minutes))
+		for _, min := range minutes {
+			out = append(out, time.Date(y, m, d, period.Hour(), min, sec, 0, loc))
+		}
+		return out
+	}
+
+	var days int
+	switch r.Freq {
+	case FreqDaily:
+		days = 1
+	case FreqWeekly:
+		days = 7
+	case FreqMonthly:
+		days = period.AddDate(0, 1, -1).Day()
+	default:
+		days = daysInYear(period.Year())
+	}
+	hours := orDefault(byHour, start.Hour())
+	minutes := orDefault(byMinute, start.Minute())
+
+	var out []time.Time
+	y, m, d := period.Date()
+	for i := range days {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, loc)
+		if !r.matchesDay(day, byMonth, byMonthDay, byDay) {
+			continue
+		}
+		dy, dm, dd := day.Date()
+		for _, h := range hours {
+			for _, min := range minutes {
+				out = append(out, time.Date(dy, dm, dd, h, min, sec, 0, loc))
+			}
+		}
+	}
+	return out
+}
+
+// matchesDay reports whether the day of t passes the BYMONTH, BYMONTHDAY and BYDAY filters.
+func (r RRule) matchesDay(t time.Time, byMonth []time.Month, byMonthDay []int, byDay []WeekdayNum) bool {
+	if len(byMonth) > 0 && !slices.Contains(byMonth, t.Month()) {
+		return false
+	}
+	if len(byMonthDay) > 0 {
+		dim := daysIn(t.Month(), t.Year())
+		if !slices.ContainsFunc(byMonthDay, func(md int) bool {
+			return md == t.Day() || md < 0 && dim+md+1 == t.Day()
+		}) {
+			return false
+		}
+	}
+	if len(byDay) > 0 {
+		// Ordinals count within the year for yearly rules, unless BYMONTH narrows
+		// them to the month, and within the month otherwise.
+		inYear := r.Freq == FreqYearly && len(r.ByMonth) == 0
+		if !slices.ContainsFunc(byDay, func(wd WeekdayNum) bool {
+			return wd.Weekday == t.Weekday() && (wd.N == 0 || weekdayOrdinalMatches(t, wd.N, inYear))
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// weekdayOrdinalMatches reports whether t is the nth of its weekday in its
+// month, or in its year if inYear is set. Negative n counts from the end.
+func weekdayOrdinalMatches(t time.Time, n int, inYear bool) bool {
+	pos, total := t.Day(), daysIn(t.Month(), t.Year())
+	if inYear {
+		pos = t.YearDay()
+		total = daysInYear(t.Year())
+	}
+	if n > 0 {
+		return (pos-1)/7+1 == n
+	}
+	return (total-pos)/7+1 == -n
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+func containsOrEmpty(list []int, v int) bool {
+	return len(list) == 0 || slices.Contains(list, v)
+}
+
+func orDefault(list []int, v int) []int {
+	if len(list) == 0 {
+		return []int{v}
+	}
+	return list
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a string or []byte column holding an RRULE, handling NULL.
+func (r *RRule) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*r = RRule{}
+		return nil
+	case string:
+		return r.parseRRuleString(v)
+	case []byte:
+		return r.parseRRuleString(string(v))
+	default:
+		return unsupportedScanType("RRule", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the rule as a string, or nil if invalid.
+func (r RRule) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the rule as a JSON string, or null if invalid.
+func (r RRule) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(make([]byte, 0, 64)), nil
+}
+
+// AppendJSON appends the JSON encoding of the RRule to b, as returned by MarshalJSON.
+func (r RRule) AppendJSON(b []byte) []byte {
+	if !r.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	return appendJSONString(b, r.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It parses a JSON string into the RRule, handling null and empty strings.
+func (r *RRule) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = RRule{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("RRule", string(data), "JSON string", err)
+	}
+	return r.parseRRuleString(s)
+}
+
+// IsZero reports whether the RRule is invalid.
+func (r RRule) IsZero() bool {
+	return !r.Valid
+}
+
+// IsNull reports whether the RRule is invalid (NULL).
+func (r RRule) IsNull() bool {
+	return !r.Valid
+}
+
+// HasValue reports whether the RRule is valid (non-NULL). It is the negation of IsNull.
+func (r RRule) HasValue() bool {
+	return r.Valid
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid RRule is encoded as empty text.
+func (r RRule) MarshalText() ([]byte, error) {
+	return r.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the rule as returned by String to b.
+func (r RRule) AppendText(b []byte) ([]byte, error) {
+	return append(b, r.String()...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses an RRULE, marking the RRule invalid if the text is empty.
+func (r *RRule) UnmarshalText(text []byte) error {
+	return r.parseRRuleString(string(text))
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the rule as a string, or nil if invalid.
+func (r RRule) LogValue() slog.Value {
+	if !r.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(r.String())
+}
@@ -0,0 +1,256 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func ts(y int, m time.Month, d, h, mi int) types.Timestamp {
+	return types.NewTimestamp(time.Date(y, m, d, h, mi, 0, 0, time.UTC))
+}
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // canonical String of the parsed rule
+	}{
+		{"", ""},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{"RRULE:BYDAY=-1FR;COUNT=6;FREQ=MONTHLY", "FREQ=MONTHLY;COUNT=6;BYDAY=-1FR"},
+		{"freq=DAILY;interval=1", "FREQ=DAILY"},
+		{"FREQ=DAILY;UNTIL=20240105T120000Z", "FREQ=DAILY;UNTIL=20240105T120000Z"},
+		{"FREQ=DAILY;UNTIL=20240105T120000", "FREQ=DAILY;UNTIL=20240105T120000Z"},
+		{"FREQ=DAILY;UNTIL=20240105", "FREQ=DAILY;UNTIL=20240105T235959Z"},
+		{"FREQ=YEARLY;BYMONTH=12,1;BYMONTHDAY=-1,1", "FREQ=YEARLY;BYMONTH=1,12;BYMONTHDAY=-1,1"},
+		{"FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30", "FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30"},
+		{"FREQ=WEEKLY;WKST=SU", "FREQ=WEEKLY;WKST=SU"},
+		{"FREQ=WEEKLY;WKST=MO", "FREQ=WEEKLY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			r, err := types.ParseRRule(tt.in)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q): %v", tt.in, err)
+			}
+			if r.Valid != (tt.in != "") {
+				t.Errorf("ParseRRule(%q).Valid = %v", tt.in, r.Valid)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("ParseRRule(%q).String() = %q, want %q", tt.in, got, tt.want)
+			}
+			back, err := types.ParseRRule(r.String())
+			if err != nil || back.String() != tt.want {
+				t.Errorf("ParseRRule(%q) = %q, %v, want a round trip", r.String(), back.String(), err)
+			}
+		})
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	tests := []string{
+		"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=-1",
+		"FREQ=SECONDLY",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;BYSECOND=0",
+		"FREQ=YEARLY;BYWEEKNO=1",
+		"COUNT=3",
+		"FREQ=DAILY;COUNT=3;UNTIL=20240101",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;INTERVAL=-1",
+		"FREQ=DAILY;UNTIL=2024-01-01",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYMINUTE=60",
+		"FREQ=WEEKLY;WKST=1MO",
+		"FREQ=DAILY;",
+		"FREQ",
+	}
+	for _, in := range tests {
+		t.Run(in, func(t *testing.T) {
+			r, err := types.ParseRRule(in)
+			if err == nil {
+				t.Fatalf("ParseRRule(%q) = %q, want error", in, r)
+			}
+			if !errors.Is(err, &types.ErrInvalidFormat{Type: "RRule"}) {
+				t.Errorf("ParseRRule(%q) error %v is not an RRule ErrInvalidFormat", in, err)
+			}
+			if r.Valid {
+				t.Errorf("ParseRRule(%q) returned a valid rule with an error", in)
+			}
+		})
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	monday := ts(2024, time.January, 1, 9, 0)
+	tests := []struct {
+		name          string
+		rule          string
+		dtstart       types.Timestamp
+		after, before types.Timestamp
+		want          []types.Timestamp
+	}{
+		{
+			name: "COUNT", rule: "FREQ=DAILY;COUNT=3", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{monday, ts(2024, time.January, 2, 9, 0), ts(2024, time.January, 3, 9, 0)},
+		},
+		{
+			name: "COUNT includes occurrences before after", rule: "FREQ=DAILY;COUNT=3", dtstart: monday,
+			after: ts(2024, time.January, 2, 0, 0), before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{ts(2024, time.January, 2, 9, 0), ts(2024, time.January, 3, 9, 0)},
+		},
+		{
+			name: "UNTIL is inclusive", rule: "FREQ=DAILY;UNTIL=20240103T090000Z", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{monday, ts(2024, time.January, 2, 9, 0), ts(2024, time.January, 3, 9, 0)},
+		},
+		{
+			name: "UNTIL as a date", rule: "FREQ=DAILY;UNTIL=20240102", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{monday, ts(2024, time.January, 2, 9, 0)},
+		},
+		{
+			name: "bounds are inclusive", rule: "FREQ=DAILY", dtstart: monday,
+			after: ts(2024, time.January, 2, 9, 0), before: ts(2024, time.January, 3, 9, 0),
+			want: []types.Timestamp{ts(2024, time.January, 2, 9, 0), ts(2024, time.January, 3, 9, 0)},
+		},
+		{
+			name: "weekly with interval", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", dtstart: monday,
+			after: monday, before: ts(2024, time.January, 31, 23, 59),
+			want: []types.Timestamp{
+				monday, ts(2024, time.January, 3, 9, 0),
+				ts(2024, time.January, 15, 9, 0), ts(2024, time.January, 17, 9, 0),
+				ts(2024, time.January, 29, 9, 0), ts(2024, time.January, 31, 9, 0),
+			},
+		},
+		{
+			name: "last Friday of the month", rule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{
+				ts(2024, time.January, 26, 9, 0), ts(2024, time.February, 23, 9, 0), ts(2024, time.March, 29, 9, 0),
+			},
+		},
+		{
+			name: "day 31 skips shorter months", rule: "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=3", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{
+				ts(2024, time.January, 31, 9, 0), ts(2024, time.March, 31, 9, 0), ts(2024, time.May, 31, 9, 0),
+			},
+		},
+		{
+			name: "last day of the month", rule: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{
+				ts(2024, time.January, 31, 9, 0), ts(2024, time.February, 29, 9, 0), ts(2024, time.March, 31, 9, 0),
+			},
+		},
+		{
+			name: "leap day", rule: "FREQ=YEARLY;COUNT=2", dtstart: ts(2024, time.February, 29, 0, 0),
+			after: ts(2024, time.January, 1, 0, 0), before: ts(2040, time.January, 1, 0, 0),
+			want: []types.Timestamp{ts(2024, time.February, 29, 0, 0), ts(2028, time.February, 29, 0, 0)},
+		},
+		{
+			name: "hours of the day", rule: "FREQ=DAILY;BYHOUR=9,17;COUNT=3", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{monday, ts(2024, time.January, 1, 17, 0), ts(2024, time.January, 2, 9, 0)},
+		},
+		{
+			name: "dtstart not matching", rule: "FREQ=WEEKLY;BYDAY=TU;COUNT=1", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: []types.Timestamp{ts(2024, time.January, 2, 9, 0)},
+		},
+		{
+			name: "empty window", rule: "FREQ=DAILY", dtstart: monday,
+			after: ts(2024, time.January, 1, 10, 0), before: ts(2024, time.January, 1, 11, 0),
+			want: nil,
+		},
+		{
+			name: "invalid bounds", rule: "FREQ=DAILY", dtstart: monday,
+			after: types.Timestamp{}, before: ts(2024, time.December, 31, 0, 0),
+			want: nil,
+		},
+		{
+			name: "invalid rule", rule: "", dtstart: monday,
+			after: monday, before: ts(2024, time.December, 31, 0, 0),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := types.ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := r.Between(tt.dtstart, tt.after, tt.before, nil)
+			if !slices.EqualFunc(got, tt.want, types.Timestamp.Equal) {
+				t.Errorf("Between = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleBetweenInLocation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 09:00 in Berlin is 08:00 UTC in winter and 07:00 UTC after the switch to
+	// summer time on 2024-03-31.
+	r, _ := types.ParseRRule("FREQ=DAILY;COUNT=2")
+	dtstart := types.NewTimestamp(time.Date(2024, time.March, 30, 9, 0, 0, 0, berlin))
+	got := r.Between(dtstart, dtstart, ts(2024, time.December, 31, 0, 0), berlin)
+	want := []types.Timestamp{ts(2024, time.March, 30, 8, 0), ts(2024, time.March, 31, 7, 0)}
+	if !slices.EqualFunc(got, want, types.Timestamp.Equal) {
+		t.Errorf("Between = %v, want %v", got, want)
+	}
+}
+
+func TestRRuleJSON(t *testing.T) {
+	tests := []struct {
+		json string
+		want string
+	}{
+		{`"FREQ=WEEKLY;BYDAY=MO"`, "FREQ=WEEKLY;BYDAY=MO"},
+		{`null`, ""},
+		{`""`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var r types.RRule
+			if err := json.Unmarshal([]byte(tt.json), &r); err != nil {
+				t.Fatal(err)
+			}
+			if r.String() != tt.want {
+				t.Errorf("Unmarshal(%s) = %q, want %q", tt.json, r, tt.want)
+			}
+			out, err := json.Marshal(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantJSON := `null`
+			if tt.want != "" {
+				wantJSON = `"` + tt.want + `"`
+			}
+			if string(out) != wantJSON {
+				t.Errorf("Marshal = %s, want %s", out, wantJSON)
+			}
+		})
+	}
+
+	var r types.RRule
+	if err := json.Unmarshal([]byte(`"FREQ=MONTHLY;BYSETPOS=1"`), &r); err == nil {
+		t.Error("Unmarshal accepted BYSETPOS, want error")
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+)
+
+// normalizeScanValue unwraps the value variants some drivers and sqlx paths
+// hand to Scan, so each Scan only has to handle the canonical driver types:
+// a *time.Time becomes a time.Time (or nil), and sql.RawBytes becomes []byte.
+func normalizeScanValue(value any) any {
+	switch v := value.(type) {
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case sql.RawBytes:
+		return []byte(v)
+	default:
+		return value
+	}
+}
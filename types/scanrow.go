@@ -0,0 +1,57 @@
+package types
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+)
+
+// ScanRow scans the current row of rows into dest, which must be a pointer to
+// a struct. It is a minimal alternative to sqlx's StructScan:
+//
+//	for rows.Next() {
+//		var u User
+//		if err := types.ScanRow(rows, &u); err != nil {
+//			return err
+//		}
+//	}
+//
+// Columns are matched to fields by the `db` struct tag, falling back to the
+// field name, ignoring case; fields tagged "-" and unexported fields are
+// skipped. Columns without a matching field are discarded. Fields are scanned
+// the same way as by rows.Scan, so the types in this package handle NULL.
+func ScanRow(rows *sql.Rows, dest any) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot scan into %T, expected a pointer to a struct", dest)
+	}
+	rv = rv.Elem()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string][]int)
+	for _, f := range fields.Of(rv.Type(), "db") {
+		byName[strings.ToLower(f.Name)] = f.Index
+	}
+
+	targets := make([]any, len(columns))
+	for i, col := range columns {
+		index, ok := byName[strings.ToLower(col)]
+		if !ok {
+			targets[i] = new(any)
+			continue
+		}
+		field, err := rv.FieldByIndexErr(index)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", col, err)
+		}
+		targets[i] = field.Addr().Interface()
+	}
+	return rows.Scan(targets...)
+}
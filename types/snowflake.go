@@ -0,0 +1,264 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// twitterEpoch is the default Snowflake epoch, 2010-11-04T01:42:54.657Z.
+var twitterEpoch = time.UnixMilli(1288834974657).UTC()
+
+// SnowflakeID is a nullable 64-bit Snowflake ID, as generated by Twitter,
+// Discord and others. It is stored as a bigint, and encoded in JSON as a
+// string, since JavaScript numbers cannot represent all 64-bit integers.
+// UnmarshalJSON accepts both strings and numbers.
+//
+// The upper bits of a Snowflake hold its creation time in milliseconds since
+// an epoch, returned by Timestamp. The epoch is Twitter's unless selected with
+// SetSnowflakeEpoch.
+type SnowflakeID struct {
+	Val   int64
+	Valid bool
+}
+
+// NewSnowflakeID creates a new valid SnowflakeID.
+func NewSnowflakeID(id int64) SnowflakeID {
+	return SnowflakeID{Val: id, Valid: true}
+}
+
+// ParseSnowflakeID parses a decimal Snowflake ID. An empty string yields an
+// invalid SnowflakeID.
+func ParseSnowflakeID(s string) (SnowflakeID, error) {
+	var id SnowflakeID
+	err := id.parseSnowflake(s)
+	return id, err
+}
+
+// NullSnowflakeID returns an invalid (NULL) SnowflakeID. It is equivalent to SnowflakeID{}.
+func NullSnowflakeID() SnowflakeID {
+	return SnowflakeID{}
+}
+
+// parseSnowflake parses s into the SnowflakeID, marking it invalid if s is empty.
+func (id *SnowflakeID) parseSnowflake(s string) error {
+	if s == "" {
+		*id = SnowflakeID{}
+		return nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return invalidFormat("SnowflakeID", s, "decimal integer", err)
+	}
+	if n < 0 {
+		return invalidFormat("SnowflakeID", s, "decimal integer", errors.New("negative ID"))
+	}
+	*id = SnowflakeID{Val: n, Valid: true}
+	return nil
+}
+
+// Timestamp returns the creation time embedded in the SnowflakeID, to the
+// millisecond, relative to the epoch selected with SetSnowflakeEpoch. An
+// invalid SnowflakeID yields an invalid Timestamp.
+func (id SnowflakeID) Timestamp() Timestamp {
+	return id.TimestampSince(loadSnowflakeEpoch())
+}
+
+// TimestampSince is like Timestamp, but relative to the given epoch, for
+// IDs from a generator other than the one selected with SetSnowflakeEpoch.
+func (id SnowflakeID) TimestampSince(epoch time.Time) Timestamp {
+	if !id.Valid {
+		return Timestamp{}
+	}
+	return NewTimestamp(epoch.Add(time.Duration(id.Val>>22)*time.Millisecond), WithoutTruncation())
+}
+
+// loadSnowflakeEpoch returns the epoch selected with SetSnowflakeEpoch.
+func loadSnowflakeEpoch() time.Time {
+	if e := snowflakeEpoch.Load(); e != nil {
+		return *e
+	}
+	return twitterEpoch
+}
+
+// Scan implements the sql.Scanner interface.
+// It converts database values into a SnowflakeID, supporting NULL, int64,
+// uint64, and decimal string and []byte values. sql.RawBytes and json.Number
+// are accepted too.
+func (id *SnowflakeID) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*id = SnowflakeID{}
+		return nil
+	case int64:
+		if v < 0 {
+			return invalidFormat("SnowflakeID", strconv.FormatInt(v, 10), "decimal integer", errors.New("negative ID"))
+		}
+		*id = SnowflakeID{Val: v, Valid: true}
+		return nil
+	case uint64:
+		return id.parseSnowflake(strconv.FormatUint(v, 10))
+	case json.Number:
+		return id.parseSnowflake(string(v))
+	case string:
+		return id.parseSnowflake(v)
+	case []byte:
+		return id.parseSnowflake(string(v))
+	default:
+		return unsupportedScanType("SnowflakeID", value)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the ID as an int64, or nil if invalid.
+func (id SnowflakeID) Value() (driver.Value, error) {
+	if !id.Valid {
+		return nil, nil
+	}
+	return id.Val, nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the ID as a decimal JSON string, or null if invalid.
+func (id SnowflakeID) MarshalJSON() ([]byte, error) {
+	return id.AppendJSON(make([]byte, 0, 22)), nil
+}
+
+// AppendJSON appends the JSON encoding of the SnowflakeID to b, as returned by MarshalJSON.
+func (id SnowflakeID) AppendJSON(b []byte) []byte {
+	if !id.Valid {
+		return appendNullJSON(b, zeroStringJSON)
+	}
+	b = append(b, '"')
+	b = strconv.AppendInt(b, id.Val, 10)
+	return append(b, '"')
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts the ID as a JSON string or number, handling null and empty strings.
+func (id *SnowflakeID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = SnowflakeID{}
+		return nil
+	}
+	if isJSONNumber(data) {
+		return id.parseSnowflake(string(data))
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return invalidFormat("SnowflakeID", string(data), "JSON string", err)
+	}
+	return id.parseSnowflake(s)
+}
+
+// IsZero reports whether the SnowflakeID is invalid.
+func (id SnowflakeID) IsZero() bool {
+	return !id.Valid
+}
+
+// IsNull reports whether the SnowflakeID is invalid (NULL).
+func (id SnowflakeID) IsNull() bool {
+	return !id.Valid
+}
+
+// HasValue reports whether the SnowflakeID is valid (non-NULL). It is the negation of IsNull.
+func (id SnowflakeID) HasValue() bool {
+	return id.Valid
+}
+
+// Equal reports whether id and other are the same ID, or both invalid.
+func (id SnowflakeID) Equal(other SnowflakeID) bool {
+	return id == other || (!id.Valid && !other.Valid)
+}
+
+// Compare returns -1, 0 or +1 depending on whether id is less than, equal to or
+// greater than other, which orders IDs from the same generator by creation time.
+// Invalid IDs sort before all valid IDs.
+func (id SnowflakeID) Compare(other SnowflakeID) int {
+	if c, ok := compareNull(id.Valid, other.Valid); ok {
+		return c
+	}
+	switch {
+	case id.Val < other.Val:
+		return -1
+	case id.Val > other.Val:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Validate returns an *ErrInvalidFormat if the SnowflakeID is valid but negative.
+func (id SnowflakeID) Validate() error {
+	if id.Valid && id.Val < 0 {
+		return invalidFormat("SnowflakeID", strconv.FormatInt(id.Val, 10), "decimal integer", errors.New("negative ID"))
+	}
+	return nil
+}
+
+// String returns the ID in decimal, or an empty string if invalid.
+// Implements the fmt.Stringer interface.
+func (id SnowflakeID) String() string {
+	if !id.Valid {
+		return ""
+	}
+	return strconv.FormatInt(id.Val, 10)
+}
+
+// Set implements the flag.Value interface.
+// It parses a decimal ID into the SnowflakeID, marking it invalid if the string is empty.
+func (id *SnowflakeID) Set(s string) error {
+	return id.parseSnowflake(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (id *SnowflakeID) Type() string {
+	return "snowflake"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid SnowflakeID is encoded as empty text.
+func (id SnowflakeID) MarshalText() ([]byte, error) {
+	return id.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the ID in decimal to b, or nothing if invalid.
+func (id SnowflakeID) AppendText(b []byte) ([]byte, error) {
+	if !id.Valid {
+		return b, nil
+	}
+	return strconv.AppendInt(b, id.Val, 10), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text marks the SnowflakeID invalid.
+func (id *SnowflakeID) UnmarshalText(text []byte) error {
+	return id.parseSnowflake(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a SnowflakeID.
+func (id *SnowflakeID) UnmarshalParam(param string) error {
+	return id.parseSnowflake(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the ID as a decimal string, or nil if invalid.
+func (id SnowflakeID) LogValue() slog.Value {
+	if !id.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(id.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (id SnowflakeID) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "SnowflakeID", "Val", id.String(), id.Valid)
+}
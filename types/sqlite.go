@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math"
+	"time"
+)
+
+// SQLiteStorage selects the representation Date and Timestamp values are sent
+// to the driver in when the SQLite dialect is selected. SQLite has no date or
+// time column types and commonly stores them as text, Unix time or Julian days.
+type SQLiteStorage int32
+
+const (
+	// SQLiteText stores values as text, as described for the SQLite dialect.
+	SQLiteText SQLiteStorage = iota
+
+	// SQLiteUnixTime stores values as integer Unix seconds.
+	SQLiteUnixTime
+
+	// SQLiteJulianDay stores values as real Julian day numbers.
+	SQLiteJulianDay
+)
+
+// julianDayUnixEpoch is the Julian day number of 1970-01-01T00:00:00Z.
+const julianDayUnixEpoch = 2440587.5
+
+// secondsPerDay is the length of a Julian day in seconds.
+const secondsPerDay = 24 * 60 * 60
+
+// julianDayToTime converts a Julian day number to a UTC time, rounded to the second.
+func julianDayToTime(jd float64) time.Time {
+	secs := math.Round((jd - julianDayUnixEpoch) * secondsPerDay)
+	return time.Unix(int64(secs), 0).UTC()
+}
+
+// timeToJulianDay converts a time to a Julian day number.
+func timeToJulianDay(t time.Time) float64 {
+	return float64(t.Unix())/secondsPerDay + julianDayUnixEpoch
+}
+
+// sqliteValue returns t in the storage representation selected with
+// SetSQLiteStorage, reporting false if values should be sent as text.
+func sqliteValue(t time.Time) (any, bool) {
+	if Dialect(dialect.Load()) != SQLite {
+		return nil, false
+	}
+	switch SQLiteStorage(sqliteStorage.Load()) {
+	case SQLiteUnixTime:
+		return t.Unix(), true
+	case SQLiteJulianDay:
+		return timeToJulianDay(t), true
+	default:
+		return nil, false
+	}
+}
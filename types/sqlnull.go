@@ -0,0 +1,119 @@
+package types
+
+import (
+	"database/sql"
+	"time"
+)
+
+// FromNullString creates a String from a sql.NullString.
+func FromNullString(ns sql.NullString) String {
+	return String{Val: ns.String, Valid: ns.Valid}
+}
+
+// ToNullString returns the String as a sql.NullString.
+func (s String) ToNullString() sql.NullString {
+	return sql.NullString{String: s.Val, Valid: s.Valid}
+}
+
+// DateFromNullTime creates a Date from a sql.NullTime, truncating the time to midnight.
+func DateFromNullTime(nt sql.NullTime) Date {
+	if !nt.Valid {
+		return Date{}
+	}
+	return NewDateIn(nt.Time, time.UTC)
+}
+
+// ToNullTime returns the Date as a sql.NullTime.
+func (d Date) ToNullTime() sql.NullTime {
+	return sql.NullTime{Time: d.Time, Valid: d.Valid}
+}
+
+// TimeFromNullTime creates a Time from the time of day of a sql.NullTime.
+func TimeFromNullTime(nt sql.NullTime) Time {
+	if !nt.Valid {
+		return Time{}
+	}
+	return NewTime(nt.Time)
+}
+
+// ToNullTime returns the Time as a sql.NullTime on January 1st of year 1.
+func (t Time) ToNullTime() sql.NullTime {
+	return sql.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
+// TimestampFromNullTime creates a Timestamp from a sql.NullTime,
+// normalizing to UTC and truncating to the second.
+func TimestampFromNullTime(nt sql.NullTime) Timestamp {
+	if !nt.Valid {
+		return Timestamp{}
+	}
+	return NewTimestamp(nt.Time)
+}
+
+// ToNullTime returns the Timestamp as a sql.NullTime.
+func (t Timestamp) ToNullTime() sql.NullTime {
+	return sql.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
+// sqlNuller is implemented by the types of this package that have an
+// equivalent sql.Null[V].
+type sqlNuller[V string | time.Time] interface {
+	toSQLNull() sql.Null[V]
+}
+
+// sqlNullSetter is implemented by pointers to the types of this package that
+// can be set from a sql.Null[V].
+type sqlNullSetter[T any, V string | time.Time] interface {
+	*T
+	setSQLNull(n sql.Null[V])
+}
+
+// ToSQLNull converts v to the equivalent sql.Null[V]: sql.Null[string] for
+// String and sql.Null[time.Time] for Date, Time and Timestamp.
+//
+//	n := types.ToSQLNull[time.Time](d)
+func ToSQLNull[V string | time.Time](v sqlNuller[V]) sql.Null[V] {
+	return v.toSQLNull()
+}
+
+// FromSQLNull converts a sql.Null[V] to the type T, which is String for
+// sql.Null[string] and one of Date, Time or Timestamp for sql.Null[time.Time].
+//
+//	d := types.FromSQLNull[types.Date](n)
+func FromSQLNull[T any, PT sqlNullSetter[T, V], V string | time.Time](n sql.Null[V]) T {
+	var v T
+	PT(&v).setSQLNull(n)
+	return v
+}
+
+func (s String) toSQLNull() sql.Null[string] {
+	return sql.Null[string]{V: s.Val, Valid: s.Valid}
+}
+
+func (s *String) setSQLNull(n sql.Null[string]) {
+	*s = FromNullString(sql.NullString{String: n.V, Valid: n.Valid})
+}
+
+func (d Date) toSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{V: d.Time, Valid: d.Valid}
+}
+
+func (d *Date) setSQLNull(n sql.Null[time.Time]) {
+	*d = DateFromNullTime(sql.NullTime{Time: n.V, Valid: n.Valid})
+}
+
+func (t Time) toSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{V: t.Time, Valid: t.Valid}
+}
+
+func (t *Time) setSQLNull(n sql.Null[time.Time]) {
+	*t = TimeFromNullTime(sql.NullTime{Time: n.V, Valid: n.Valid})
+}
+
+func (t Timestamp) toSQLNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{V: t.Time, Valid: t.Valid}
+}
+
+func (t *Timestamp) setSQLNull(n sql.Null[time.Time]) {
+	*t = TimestampFromNullTime(sql.NullTime{Time: n.V, Valid: n.Valid})
+}
@@ -4,6 +4,9 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"strings"
+	"unsafe"
 )
 
 // String is a custom type for handling nullable strings.
@@ -19,9 +22,16 @@ func NewString(s string) String {
 	return String{Val: s, Valid: true}
 }
 
+// NullString returns an invalid (NULL) String. It is equivalent to String{}.
+func NullString() String {
+	return String{}
+}
+
 // Scan implements the sql.Scanner interface.
 // It converts database values into a String, supporting NULL, string, and []byte.
+// sql.RawBytes and json.Number are accepted too.
 func (s *String) Scan(value any) error {
+	value = normalizeScanValue(value)
 	if value == nil {
 		s.Val, s.Valid = "", false
 		return nil
@@ -33,14 +43,31 @@ func (s *String) Scan(value any) error {
 		s.Valid = true
 		return nil
 	case []byte:
+		if zeroCopyScan.Load() {
+			// Aliases the driver's buffer, see SetZeroCopyScan.
+			s.Val = unsafe.String(unsafe.SliceData(v), len(v))
+			s.Valid = true
+			return nil
+		}
 		s.Val = string(v)
 		s.Valid = true
 		return nil
+	case json.Number:
+		s.Val = v.String()
+		s.Valid = true
+		return nil
 	default:
-		return fmt.Errorf("cannot scan %T into String", value)
+		return unsupportedScanType("String", value)
 	}
 }
 
+// Clone returns a copy of the String that does not share memory with s. Use it
+// to retain a String scanned with SetZeroCopyScan enabled past the next call to
+// Next, Scan or Close on the rows.
+func (s String) Clone() String {
+	return String{Val: strings.Clone(s.Val), Valid: s.Valid}
+}
+
 // Value implements the driver.Valuer interface.
 // It returns the string value for database storage, or nil if invalid.
 func (s String) Value() (driver.Value, error) {
@@ -53,10 +80,15 @@ func (s String) Value() (driver.Value, error) {
 // MarshalJSON implements the json.Marshaler interface.
 // It encodes the string as a JSON string, or null if invalid.
 func (s String) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, len(s.Val)+2)), nil
+}
+
+// AppendJSON appends the JSON encoding of the String to b, as returned by MarshalJSON.
+func (s String) AppendJSON(b []byte) []byte {
 	if !s.Valid {
-		return []byte("null"), nil
+		return appendNullJSON(b, zeroStringJSON)
 	}
-	return json.Marshal(s.Val)
+	return appendJSONString(b, s.Val)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -69,7 +101,7 @@ func (s *String) UnmarshalJSON(data []byte) error {
 
 	var str string
 	if err := json.Unmarshal(data, &str); err != nil {
-		return fmt.Errorf("invalid string format: %w", err)
+		return invalidFormat("String", string(data), "JSON string", err)
 	}
 	s.Val = str
 	s.Valid = true
@@ -77,9 +109,30 @@ func (s *String) UnmarshalJSON(data []byte) error {
 }
 
 // IsZero returns true if the String is invalid or contains an empty string.
-// Useful for omitempty behavior in JSON or zero-value checks.
+// Useful for omitzero behavior in JSON or zero-value checks.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid String is zero.
 func (s String) IsZero() bool {
-	return !s.Valid || s.Val == ""
+	return isZero(s.Valid, s.Val == "")
+}
+
+// IsNull reports whether the String is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s String) IsNull() bool {
+	return !s.Valid
+}
+
+// HasValue reports whether the String is valid (non-NULL). It is the negation of IsNull.
+func (s String) HasValue() bool {
+	return s.Valid
+}
+
+// MustString returns the string held by the String, panicking if it is invalid (NULL).
+// Use it where NULL has already been ruled out, e.g. in tests.
+func (s String) MustString() string {
+	if !s.Valid {
+		panic("types: MustString called on a NULL String")
+	}
+	return s.Val
 }
 
 // String returns the underlying string value, or an empty string if invalid.
@@ -99,3 +152,58 @@ func (s String) Ptr() *string {
 	}
 	return &s.Val
 }
+
+// Set implements the flag.Value interface.
+// Any value passed on the command line, including an empty one, yields a valid String.
+func (s *String) Set(v string) error {
+	s.Val = v
+	s.Valid = true
+	return nil
+}
+
+// Type returns the type name shown in pflag usage output.
+func (s *String) Type() string {
+	return "string"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid String is encoded as empty text.
+func (s String) MarshalText() ([]byte, error) {
+	return s.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the String's value to b, or nothing if invalid.
+func (s String) AppendText(b []byte) ([]byte, error) {
+	if !s.Valid {
+		return b, nil
+	}
+	return append(b, s.Val...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Any text, including empty text, yields a valid String.
+func (s *String) UnmarshalText(text []byte) error {
+	return s.Set(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a String.
+func (s *String) UnmarshalParam(param string) error {
+	return s.Set(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the underlying string value, or nil if invalid.
+func (s String) LogValue() slog.Value {
+	if !s.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(s.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (s String) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "String", "Val", s.String(), s.Valid)
+}
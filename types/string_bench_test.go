@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+var benchString = types.NewString("hello, world")
+
+func BenchmarkStringScan(b *testing.B) {
+	var s types.String
+	benchScan(b, &s, []byte("hello, world"))
+}
+
+func BenchmarkStringScanString(b *testing.B) {
+	var s types.String
+	benchScan(b, &s, "hello, world")
+}
+
+func BenchmarkStringValue(b *testing.B) {
+	benchValue(b, benchString)
+}
+
+func BenchmarkStringAppendJSON(b *testing.B) {
+	benchAppendJSON(b, benchString)
+}
+
+func BenchmarkStringMarshalJSON(b *testing.B) {
+	benchMarshalJSON(b, benchString)
+}
+
+func BenchmarkStringUnmarshalJSON(b *testing.B) {
+	var s types.String
+	benchUnmarshalJSON(b, &s, []byte(`"hello, world"`))
+}
+
+func TestStringAllocs(t *testing.T) {
+	var s types.String
+	var bytesSrc, stringSrc any = []byte("hello, world"), "hello, world"
+	buf := make([]byte, 0, 64)
+	data := []byte(`"hello, world"`)
+
+	// Scan copies []byte, which the driver may reuse, unless SetZeroCopyScan is enabled.
+	assertAllocs(t, "Scan([]byte)", 1, func() { _ = s.Scan(bytesSrc) })
+	assertAllocs(t, "Scan(string)", 0, func() { _ = s.Scan(stringSrc) })
+	assertAllocs(t, "AppendJSON", 0, func() { buf = benchString.AppendJSON(buf[:0]) })
+	assertAllocs(t, "MarshalJSON", 1, func() { bytesSink, _ = benchString.MarshalJSON() })
+	// The decoded string.
+	assertAllocs(t, "UnmarshalJSON", 1, func() { _ = s.UnmarshalJSON(data) })
+	// Boxing the string into a driver.Value.
+	assertAllocs(t, "Value", 1, func() { valueSink, _ = benchString.Value() })
+}
@@ -0,0 +1,265 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+)
+
+// StringSet is a nullable set of distinct strings that keeps the order in
+// which they were first added, e.g. for labels or permissions. It is stored in
+// PostgreSQL as a text[] and encoded in JSON as an array of strings.
+//
+// The zero StringSet is NULL; Add and NewStringSet yield a valid, possibly
+// empty, set. Methods never modify a set shared with another StringSet value,
+// so StringSets can be copied freely.
+type StringSet struct {
+	vals  []string
+	valid bool
+}
+
+// NewStringSet returns a valid StringSet containing the given strings, in
+// order, without duplicates.
+func NewStringSet(vals ...string) StringSet {
+	s := StringSet{valid: true}
+	s.Add(vals...)
+	return s
+}
+
+// NullStringSet returns a NULL StringSet. It is equivalent to StringSet{}.
+func NullStringSet() StringSet {
+	return StringSet{}
+}
+
+// Valid reports whether the StringSet is non-NULL.
+func (s StringSet) Valid() bool {
+	return s.valid
+}
+
+// Len returns the number of strings in the set.
+func (s StringSet) Len() int {
+	return len(s.vals)
+}
+
+// Values returns the strings in the order they were added. The slice is a copy.
+func (s StringSet) Values() []string {
+	return slices.Clone(s.vals)
+}
+
+// Add appends the given strings that are not yet in the set, marking it valid.
+func (s *StringSet) Add(vals ...string) {
+	s.valid = true
+	switch len(vals) {
+	case 0:
+		return
+	case 1:
+		if !slices.Contains(s.vals, vals[0]) {
+			// Clipped so that append copies rather than writing into a backing
+			// array shared with another StringSet.
+			s.vals = append(slices.Clip(s.vals), vals[0])
+		}
+		return
+	}
+	seen := stringIndex(s.vals, len(vals))
+	// Clipped so that the first append copies once, rather than writing into a
+	// backing array shared with another StringSet.
+	out := slices.Clip(s.vals)
+	for _, v := range vals {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			out = append(out, v)
+		}
+	}
+	s.vals = out
+}
+
+// stringIndex returns the set of vals, with room for extra more strings.
+func stringIndex(vals []string, extra int) map[string]struct{} {
+	index := make(map[string]struct{}, len(vals)+extra)
+	for _, v := range vals {
+		index[v] = struct{}{}
+	}
+	return index
+}
+
+// Remove removes the given strings from the set, keeping the order of the others.
+func (s *StringSet) Remove(vals ...string) {
+	remove := stringIndex(vals, 0)
+	out := make([]string, 0, len(s.vals))
+	for _, v := range s.vals {
+		if _, ok := remove[v]; !ok {
+			out = append(out, v)
+		}
+	}
+	if len(out) != len(s.vals) {
+		s.vals = out
+	}
+}
+
+// Contains reports whether v is in the set.
+func (s StringSet) Contains(v string) bool {
+	return slices.Contains(s.vals, v)
+}
+
+// Union returns the strings in s, followed by those only in o. The result is
+// NULL only if both are NULL.
+func (s StringSet) Union(o StringSet) StringSet {
+	out := StringSet{vals: make([]string, 0, len(s.vals)+len(o.vals)), valid: s.valid || o.valid}
+	out.vals = append(out.vals, s.vals...)
+	seen := stringIndex(s.vals, 0)
+	for _, v := range o.vals {
+		if _, ok := seen[v]; !ok {
+			out.vals = append(out.vals, v)
+		}
+	}
+	return out
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL text[] in text form, such as {admin,"read only"},
+// handling NULL. Duplicates are removed and NULL elements ignored.
+func (s *StringSet) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*s = StringSet{}
+		return nil
+	case []byte:
+		return s.parseArray(string(v))
+	case string:
+		return s.parseArray(v)
+	default:
+		return unsupportedScanType("StringSet", value)
+	}
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal of strings.
+func (s *StringSet) parseArray(text string) error {
+	var vals []string
+	err := scanArrayLiteral(text, func(_ int, elem string, null bool) error {
+		if !null {
+			vals = append(vals, elem)
+		}
+		return nil
+	})
+	if err != nil {
+		return invalidFormat("StringSet", text, "text[] literal", err)
+	}
+	*s = NewStringSet(vals...)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the set as a PostgreSQL text[] literal, or nil if NULL.
+func (s StringSet) Value() (driver.Value, error) {
+	if !s.valid {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// String returns the set as a PostgreSQL array literal, e.g. {admin,"read only"},
+// or an empty string if NULL.
+func (s StringSet) String() string {
+	if !s.valid {
+		return ""
+	}
+	b := make([]byte, 0, 2+len(s.vals)*8)
+	b = append(b, '{')
+	for i, v := range s.vals {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendArrayElem(b, v)
+	}
+	return string(append(b, '}'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the set as a JSON array of strings, or null if NULL.
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, 2+len(s.vals)*10)), nil
+}
+
+// AppendJSON appends the JSON encoding of the StringSet to b, as returned by MarshalJSON.
+func (s StringSet) AppendJSON(b []byte) []byte {
+	if !s.valid {
+		return appendNullJSON(b, zeroArrayJSON)
+	}
+	b = append(b, '[')
+	for i, v := range s.vals {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendJSONString(b, v)
+	}
+	return append(b, ']')
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of strings, handling null. Duplicates are removed and
+// null elements ignored.
+func (s *StringSet) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = StringSet{}
+		return nil
+	}
+	var vals []*string
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return invalidFormat("StringSet", string(data), "JSON array of strings", err)
+	}
+	strs := make([]string, 0, len(vals))
+	for _, v := range vals {
+		if v != nil {
+			strs = append(strs, *v)
+		}
+	}
+	*s = NewStringSet(strs...)
+	return nil
+}
+
+// IsZero reports whether the StringSet is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL StringSet is zero.
+func (s StringSet) IsZero() bool {
+	return isZero(s.valid, len(s.vals) == 0)
+}
+
+// IsNull reports whether the StringSet is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s StringSet) IsNull() bool {
+	return !s.valid
+}
+
+// HasValue reports whether the StringSet is valid (non-NULL). It is the negation of IsNull.
+func (s StringSet) HasValue() bool {
+	return s.valid
+}
+
+// Equal reports whether s and other contain the same strings, in any order, or
+// are both NULL. An empty set is not equal to a NULL one.
+func (s StringSet) Equal(other StringSet) bool {
+	if !s.valid || !other.valid {
+		return s.valid == other.valid
+	}
+	if len(s.vals) != len(other.vals) {
+		return false
+	}
+	seen := stringIndex(other.vals, 0)
+	for _, v := range s.vals {
+		if _, ok := seen[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate returns the error of the first string in the set that does not
+// validate as a String.
+func (s StringSet) Validate() error {
+	for _, v := range s.vals {
+		if err := NewString(v).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"database/sql/driver"
+	"strings"
+	"unicode/utf8"
+)
+
+// TagRules are the normalization rules Tags applies to each tag on input, as
+// selected with SetTagRules. Tags that are empty after normalization are dropped.
+type TagRules struct {
+	// Trim removes leading and trailing white space.
+	Trim bool
+	// Lowercase maps the tag to lower case.
+	Lowercase bool
+	// MaxLen truncates the tag to at most MaxLen runes, if positive.
+	MaxLen int
+	// Allowed, if set, reports whether a rune may appear in a tag. Other runes
+	// are removed, e.g. with
+	//
+	//	Allowed: func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' }
+	Allowed func(r rune) bool
+}
+
+// defaultTagRules are the TagRules used until SetTagRules is called.
+var defaultTagRules = TagRules{Trim: true, Lowercase: true}
+
+// loadTagRules returns the rules selected with SetTagRules.
+func loadTagRules() TagRules {
+	if r := tagRules.Load(); r != nil {
+		return *r
+	}
+	return defaultTagRules
+}
+
+// Normalize returns tag normalized according to the rules. Disallowed runes
+// are removed before trimming, and the result is truncated last.
+func (r TagRules) Normalize(tag string) string {
+	if r.Allowed != nil {
+		tag = strings.Map(func(c rune) rune {
+			if r.Allowed(c) {
+				return c
+			}
+			return -1
+		}, tag)
+	}
+	if r.Trim {
+		tag = strings.TrimSpace(tag)
+	}
+	if r.Lowercase {
+		tag = strings.ToLower(tag)
+	}
+	if r.MaxLen > 0 && utf8.RuneCountInString(tag) > r.MaxLen {
+		n := 0
+		for i := range tag {
+			if n == r.MaxLen {
+				tag = tag[:i]
+				break
+			}
+			n++
+		}
+		if r.Trim {
+			tag = strings.TrimSpace(tag)
+		}
+	}
+	return tag
+}
+
+// Tags is a StringSet of user-entered tags, normalized on input according to
+// the rules selected with SetTagRules: by default, tags are trimmed and
+// lowercased, so " Go", "go" and "GO " are the same tag. It is stored in
+// PostgreSQL as a text[] and encoded in JSON as an array of strings.
+//
+// Normalization applies to Add, Remove and Contains, and to scanned and
+// decoded tags. Like StringSet, the zero Tags is NULL, and Tags can be copied
+// freely.
+type Tags struct {
+	set StringSet
+}
+
+// NewTags returns a valid Tags containing the given tags, normalized, in order,
+// without duplicates.
+func NewTags(tags ...string) Tags {
+	var t Tags
+	t.Add(tags...)
+	return t
+}
+
+// NullTags returns a NULL Tags. It is equivalent to Tags{}.
+func NullTags() Tags {
+	return Tags{}
+}
+
+// normalizeTags returns the normalized tags, dropping empty ones.
+func normalizeTags(tags []string) []string {
+	rules := loadTagRules()
+	out := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = rules.Normalize(tag); tag != "" {
+			out = append(out, tag)
+		}
+	}
+	return out
+}
+
+// Valid reports whether the Tags is non-NULL.
+func (t Tags) Valid() bool {
+	return t.set.valid
+}
+
+// Len returns the number of tags.
+func (t Tags) Len() int {
+	return t.set.Len()
+}
+
+// Values returns the tags in the order they were added. The slice is a copy.
+func (t Tags) Values() []string {
+	return t.set.Values()
+}
+
+// StringSet returns the tags as a StringSet.
+func (t Tags) StringSet() StringSet {
+	return t.set
+}
+
+// Add normalizes the given tags and appends those that are not yet present,
+// marking the Tags valid.
+func (t *Tags) Add(tags ...string) {
+	t.set.Add(normalizeTags(tags)...)
+}
+
+// Remove normalizes the given tags and removes them.
+func (t *Tags) Remove(tags ...string) {
+	t.set.Remove(normalizeTags(tags)...)
+}
+
+// Contains reports whether tag, once normalized, is present.
+func (t Tags) Contains(tag string) bool {
+	return t.set.Contains(loadTagRules().Normalize(tag))
+}
+
+// Union returns the tags in t, followed by those only in o. The result is NULL
+// only if both are NULL.
+func (t Tags) Union(o Tags) Tags {
+	return Tags{set: t.set.Union(o.set)}
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL text[] as StringSet.Scan does, normalizing each tag.
+func (t *Tags) Scan(value any) error {
+	var set StringSet
+	if err := set.Scan(value); err != nil {
+		return err
+	}
+	t.fromSet(set)
+	return nil
+}
+
+// fromSet sets the Tags to the normalized strings of set, keeping its validity.
+func (t *Tags) fromSet(set StringSet) {
+	if !set.valid {
+		*t = Tags{}
+		return
+	}
+	*t = NewTags(set.vals...)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the tags as a PostgreSQL text[] literal, or nil if NULL.
+func (t Tags) Value() (driver.Value, error) {
+	return t.set.Value()
+}
+
+// String returns the tags as a PostgreSQL array literal, e.g. {go,sql}, or an
+// empty string if NULL.
+func (t Tags) String() string {
+	return t.set.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the tags as a JSON array of strings, or null if NULL.
+func (t Tags) MarshalJSON() ([]byte, error) {
+	return t.set.MarshalJSON()
+}
+
+// AppendJSON appends the JSON encoding of the Tags to b, as returned by MarshalJSON.
+func (t Tags) AppendJSON(b []byte) []byte {
+	return t.set.AppendJSON(b)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of strings as StringSet.UnmarshalJSON does,
+// normalizing each tag.
+func (t *Tags) UnmarshalJSON(data []byte) error {
+	var set StringSet
+	if err := set.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	t.fromSet(set)
+	return nil
+}
+
+// IsZero reports whether the Tags is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL Tags is zero.
+func (t Tags) IsZero() bool {
+	return t.set.IsZero()
+}
+
+// IsNull reports whether the Tags is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (t Tags) IsNull() bool {
+	return t.set.IsNull()
+}
+
+// HasValue reports whether the Tags is valid (non-NULL). It is the negation of IsNull.
+func (t Tags) HasValue() bool {
+	return t.set.HasValue()
+}
+
+// Equal reports whether t and other contain the same tags, in any order, or
+// are both NULL. An empty Tags is not equal to a NULL one.
+func (t Tags) Equal(other Tags) bool {
+	return t.set.Equal(other.set)
+}
+
+// Validate returns the error of the first tag that does not validate as a String.
+func (t Tags) Validate() error {
+	return t.set.Validate()
+}
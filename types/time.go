@@ -2,7 +2,9 @@ package types
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 )
 
@@ -17,6 +19,9 @@ type Time struct {
 // Defines the layout for parsing/formatting times (24-hour HH:MM).
 const timeFormat = "15:04"
 
+// errInvalidSeconds is wrapped when seconds following HH:MM are out of range.
+var errInvalidSeconds = errors.New("invalid seconds")
+
 // NewTime creates a new valid Time from a time.Time,
 // stripping away the date and seconds while keeping only HH:MM.
 func NewTime(t time.Time) Time {
@@ -27,9 +32,16 @@ func NewTime(t time.Time) Time {
 	}
 }
 
+// NullTime returns an invalid (NULL) Time. It is equivalent to Time{}.
+func NullTime() Time {
+	return Time{}
+}
+
 // Scan implements the sql.Scanner interface.
 // It converts database values into a Time, handling NULL, time.Time, []byte, and string values.
+// *time.Time and sql.RawBytes are accepted too.
 func (t *Time) Scan(value any) error {
+	value = normalizeScanValue(value)
 	if value == nil {
 		t.Time, t.Valid = time.Time{}, false
 		return nil
@@ -46,19 +58,39 @@ func (t *Time) Scan(value any) error {
 	case string:
 		return t.parseTimeString(v)
 	default:
-		return fmt.Errorf("cannot scan %T into Time", value)
+		return unsupportedScanType("Time", value)
 	}
 }
 
 // parseTimeString parses a string in HH:MM format into a Time.
 // If the string is empty, the Time is set invalid.
-// If longer than 5 characters, only the first 5 are considered.
+// If longer than 5 characters, only the first 5 are considered, although
+// seconds following HH:MM must still be in range; this accepts PostgreSQL
+// timetz output such as 15:04:05+02. With SetStrictTime enabled, longer input
+// is rejected unless it is HH:MM:SS with optional fraction.
 func (t *Time) parseTimeString(s string) error {
 	if s == "" {
 		t.Time, t.Valid = time.Time{}, false
 		return nil
 	}
 
+	if parsed, ok := parseTimeFast(s); ok {
+		t.Time, t.Valid = parsed, true
+		return nil
+	}
+	if layout := loadLayout(&timeLayout); layout != "" {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			*t = NewTime(parsed)
+			return nil
+		}
+	}
+	if secondsOutOfRange(s) {
+		return invalidFormat("Time", s, "HH:MM", errInvalidSeconds)
+	}
+	if strictTime.Load() {
+		return invalidFormat("Time", s, "HH:MM[:SS[.fraction]]", nil)
+	}
+
 	// Trim to HH:MM if input includes seconds or other trailing characters
 	if len(s) > 5 {
 		s = s[:5]
@@ -66,34 +98,63 @@ func (t *Time) parseTimeString(s string) error {
 
 	parsed, err := time.Parse(timeFormat, s)
 	if err != nil {
-		return fmt.Errorf("invalid time format, expected HH:MM: %w", err)
+		return invalidFormat("Time", s, "HH:MM", err)
 	}
 	t.Time = time.Date(1, 1, 1, parsed.Hour(), parsed.Minute(), 0, 0, time.UTC)
 	t.Valid = true
 	return nil
 }
 
+// secondsOutOfRange reports whether s is a valid HH:MM followed by two digits of
+// seconds greater than 59.
+func secondsOutOfRange(s string) bool {
+	if len(s) < 8 || s[5] != ':' {
+		return false
+	}
+	if _, ok := parseTimeFast(s[:5]); !ok {
+		return false
+	}
+	second, ok := atoi(s[6:8])
+	return ok && second > 59
+}
+
 // Value implements the driver.Valuer interface.
-// It converts the Time into a database-compatible value (string or NULL).
+// It converts the Time into a database-compatible value (string or NULL),
+// formatted for the dialect selected with SetDialect, or a time.Time if
+// selected with SetTimeValueKind.
 func (t Time) Value() (driver.Value, error) {
 	if !t.Valid {
 		return nil, nil
 	}
-	return t.Time.Format(timeFormat), nil
+	return timeValue(t.Time), nil
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // It converts the Time into a JSON string ("HH:MM") or null if invalid.
 func (t Time) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(make([]byte, 0, jsonBufSize)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Time to b, as returned by MarshalJSON.
+// With SetProtoJSON enabled, the Time is encoded as a google.type.TimeOfDay object.
+func (t Time) AppendJSON(b []byte) []byte {
 	if !t.Valid {
-		return []byte("null"), nil
+		return appendNullJSON(b, zeroTimeJSON)
 	}
-	str := fmt.Sprintf(`"%s"`, t.Time.Format(timeFormat))
-	return []byte(str), nil
+	if protoJSON.Load() && !canonicalOutput.Load() {
+		return appendProtoTimeOfDay(b, t.Time)
+	}
+	if layout := outputLayout(&timeLayout); layout != "" {
+		return appendJSONString(b, t.Time.Format(layout))
+	}
+	b = append(b, '"')
+	b = appendClock(b, t.Time)
+	return append(b, '"')
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // It parses a JSON string into a Time, handling null and empty strings.
+// A google.type.TimeOfDay object, as produced by protojson, is also accepted.
 func (t *Time) UnmarshalJSON(data []byte) error {
 	str := string(data)
 	if str == "null" || str == `""` {
@@ -101,6 +162,23 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if len(str) > 0 && str[0] == '{' {
+		tod, err := parseProtoTimeOfDay(data)
+		if err != nil {
+			return err
+		}
+		t.Time, t.Valid = tod, true
+		return nil
+	}
+
+	if strictJSON.Load() {
+		s, err := unquoteJSONStrict(data, "Time")
+		if err != nil {
+			return err
+		}
+		return t.parseTimeStrict(s)
+	}
+
 	// Remove surrounding quotes if present
 	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
 		str = str[1 : len(str)-1]
@@ -109,16 +187,119 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	return t.parseTimeString(str)
 }
 
-// IsZero reports whether the Time is invalid or represents the zero value.
+// parseTimeStrict is parseTimeString for SetStrictJSON: only HH:MM, or the
+// layout set with SetTimeFormat, is accepted, without truncating longer input.
+func (t *Time) parseTimeStrict(s string) error {
+	if s == "" {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	if len(s) == len(timeFormat) {
+		if parsed, ok := parseTimeFast(s); ok {
+			t.Time, t.Valid = parsed, true
+			return nil
+		}
+	}
+	if layout := loadLayout(&timeLayout); layout != "" {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			*t = NewTime(parsed)
+			return nil
+		}
+	}
+	return invalidFormat("Time", s, "HH:MM", nil)
+}
+
+// IsZero reports whether the Time is invalid or represents the zero value (00:00).
+// With the NullOnly policy set with SetZeroPolicy, only an invalid Time is zero.
 func (t Time) IsZero() bool {
-	return !t.Valid || t.Time.IsZero()
+	return isZero(t.Valid, t.Time.IsZero())
 }
 
-// String returns the Time formatted as "HH:MM", or an empty string if invalid.
+// IsNull reports whether the Time is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (t Time) IsNull() bool {
+	return !t.Valid
+}
+
+// HasValue reports whether the Time is valid (non-NULL). It is the negation of IsNull.
+func (t Time) HasValue() bool {
+	return t.Valid
+}
+
+// MustTime returns the time of day held by the Time, panicking if it is invalid (NULL).
+// Use it where NULL has already been ruled out, e.g. in tests.
+func (t Time) MustTime() time.Time {
+	if !t.Valid {
+		panic("types: MustTime called on a NULL Time")
+	}
+	return t.Time
+}
+
+// String returns the Time formatted as "HH:MM", or in the layout set with
+// SetTimeFormat, or an empty string if invalid.
 // Implements the fmt.Stringer interface.
 func (t Time) String() string {
 	if !t.Valid {
 		return ""
 	}
+	if layout := outputLayout(&timeLayout); layout != "" {
+		return t.Time.Format(layout)
+	}
 	return t.Time.Format(timeFormat)
 }
+
+// Set implements the flag.Value interface.
+// It parses an HH:MM string into the Time, marking it invalid if the string is empty.
+func (t *Time) Set(s string) error {
+	return t.parseTimeString(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (t *Time) Type() string {
+	return "time"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Time is encoded as empty text.
+func (t Time) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the Time as returned by String to b.
+func (t Time) AppendText(b []byte) ([]byte, error) {
+	if !t.Valid {
+		return b, nil
+	}
+	if layout := outputLayout(&timeLayout); layout != "" {
+		return t.Time.AppendFormat(b, layout), nil
+	}
+	return appendClock(b, t.Time), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses an HH:MM string into the Time, marking it invalid if the text is empty.
+func (t *Time) UnmarshalText(text []byte) error {
+	return t.parseTimeString(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Time.
+func (t *Time) UnmarshalParam(param string) error {
+	return t.parseTimeString(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the Time as an "HH:MM" string, or nil if invalid.
+func (t Time) LogValue() slog.Value {
+	if !t.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(t.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (t Time) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Time", "Time", t.String(), t.Valid)
+}
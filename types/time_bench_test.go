@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+var benchTime = types.NewTime(time.Date(1, 1, 1, 15, 4, 0, 0, time.UTC))
+
+func BenchmarkTimeScan(b *testing.B) {
+	var tm types.Time
+	benchScan(b, &tm, []byte("15:04"))
+}
+
+func BenchmarkTimeScanTime(b *testing.B) {
+	var tm types.Time
+	benchScan(b, &tm, benchTime.Time)
+}
+
+func BenchmarkTimeValue(b *testing.B) {
+	benchValue(b, benchTime)
+}
+
+func BenchmarkTimeAppendJSON(b *testing.B) {
+	benchAppendJSON(b, benchTime)
+}
+
+func BenchmarkTimeMarshalJSON(b *testing.B) {
+	benchMarshalJSON(b, benchTime)
+}
+
+func BenchmarkTimeUnmarshalJSON(b *testing.B) {
+	var tm types.Time
+	benchUnmarshalJSON(b, &tm, []byte(`"15:04"`))
+}
+
+func TestTimeAllocs(t *testing.T) {
+	var tm types.Time
+	var bytesSrc, stringSrc, timeSrc any = []byte("15:04"), "15:04", benchTime.Time
+	buf := make([]byte, 0, 64)
+	data := []byte(`"15:04"`)
+
+	assertAllocs(t, "Scan([]byte)", 0, func() { _ = tm.Scan(bytesSrc) })
+	assertAllocs(t, "Scan(string)", 0, func() { _ = tm.Scan(stringSrc) })
+	assertAllocs(t, "Scan(time.Time)", 0, func() { _ = tm.Scan(timeSrc) })
+	assertAllocs(t, "AppendJSON", 0, func() { buf = benchTime.AppendJSON(buf[:0]) })
+	assertAllocs(t, "MarshalJSON", 1, func() { bytesSink, _ = benchTime.MarshalJSON() })
+	assertAllocs(t, "UnmarshalJSON", 0, func() { _ = tm.UnmarshalJSON(data) })
+	// The formatted string, and boxing it into a driver.Value.
+	assertAllocs(t, "Value", 2, func() { valueSink, _ = benchTime.Value() })
+}
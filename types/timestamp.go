@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"time"
 )
 
@@ -20,13 +22,29 @@ const timestampFormat = time.RFC3339
 
 // NewTimestamp creates a new valid Timestamp from a time.Time,
 // normalizing to UTC and truncating to the nearest second.
-func NewTimestamp(t time.Time) Timestamp {
+// WithoutUTC and WithoutTruncation skip either step.
+func NewTimestamp(t time.Time, opts ...NewOption) Timestamp {
+	if len(opts) > 0 {
+		o := applyNewOptions(opts)
+		if !o.keepLocation {
+			t = t.UTC()
+		}
+		if !o.keepPrecision {
+			t = t.Truncate(time.Second)
+		}
+		return Timestamp{Time: t, Valid: true}
+	}
 	return Timestamp{
 		Time:  t.UTC().Truncate(time.Second),
 		Valid: true,
 	}
 }
 
+// NullTimestamp returns an invalid (NULL) Timestamp. It is equivalent to Timestamp{}.
+func NullTimestamp() Timestamp {
+	return Timestamp{}
+}
+
 // CombineDateAndTime creates a new valid Timestamp from separate Date and Time values,
 func CombineDateAndTime(d Date, t Time) Timestamp {
 	date := d.Time
@@ -44,8 +62,10 @@ func CombineDateAndTime(d Date, t Time) Timestamp {
 
 // Scan implements the sql.Scanner interface.
 // It converts database values into a Timestamp, handling NULL, time.Time,
-// []byte, and string values.
+// []byte, and string values. *time.Time and sql.RawBytes are accepted too, as are
+// Unix seconds (int64 or json.Number) and Julian day numbers (float64).
 func (t *Timestamp) Scan(value any) error {
+	value = normalizeScanValue(value)
 	if value == nil {
 		t.Time, t.Valid = time.Time{}, false
 		return nil
@@ -53,54 +73,136 @@ func (t *Timestamp) Scan(value any) error {
 
 	switch v := value.(type) {
 	case time.Time:
-		t.Time = v.UTC().Truncate(time.Second)
-		t.Valid = true
-		return nil
+		if mysqlZeroDates.Load() && v.IsZero() {
+			// go-sql-driver/mysql with parseTime=true returns zero dates as time.Time{}.
+			t.Time, t.Valid = time.Time{}, false
+			return nil
+		}
+		return t.setChecked(v.UTC().Truncate(time.Second), "")
+	case int64:
+		// Unix seconds, as commonly stored by SQLite.
+		return t.setChecked(time.Unix(v, 0).UTC(), strconv.FormatInt(v, 10))
+	case float64:
+		// Julian day number, as produced by SQLite's julianday().
+		return t.setChecked(julianDayToTime(v), strconv.FormatFloat(v, 'f', -1, 64))
+	case json.Number:
+		// Unix seconds, as decoded by json.Decoder.UseNumber.
+		n, err := v.Int64()
+		if err != nil {
+			return invalidFormat("Timestamp", string(v), "Unix seconds", err)
+		}
+		return t.Scan(n)
 	case []byte:
-		return t.parseTimestampString(string(v))
+		return t.scanTimestampString(string(v))
 	case string:
-		return t.parseTimestampString(v)
+		return t.scanTimestampString(v)
 	default:
-		return fmt.Errorf("cannot scan %T into Timestamp", value)
+		return unsupportedScanType("Timestamp", value)
 	}
 }
 
 // parseTimestampString parses an RFC3339-formatted string into a Timestamp.
 // If the string is empty, the Timestamp is set invalid.
+// PostgreSQL's "infinity" and "-infinity" are accepted as well.
 func (t *Timestamp) parseTimestampString(s string) error {
 	if s == "" {
 		t.Time, t.Valid = time.Time{}, false
 		return nil
 	}
+	if inf, ok := parseInfinity(s); ok {
+		t.Time, t.Valid = inf, true
+		return nil
+	}
+	if parsed, ok := parseRFC3339Fast(s); ok {
+		return t.setChecked(parsed, s)
+	}
+	if layout := loadLayout(&timestampLayout); layout != "" {
+		if parsed, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t.setChecked(parsed.UTC().Truncate(time.Second), s)
+		}
+	}
 	parsed, err := time.Parse(timestampFormat, s)
 	if err != nil {
-		return fmt.Errorf("invalid timestamp format, expected RFC3339: %w", err)
+		return invalidFormat("Timestamp", s, "RFC3339", err)
 	}
-	t.Time = parsed.UTC().Truncate(time.Second)
-	t.Valid = true
+	return t.setChecked(parsed.UTC().Truncate(time.Second), s)
+}
+
+// setChecked sets the Timestamp to ts, parsed from input, if ts lies within the
+// bounds selected with SetBounds. An empty input stands for ts itself in errors.
+func (t *Timestamp) setChecked(ts time.Time, input string) error {
+	if err := checkBounds("Timestamp", ts, input); err != nil {
+		return err
+	}
+	t.Time, t.Valid = ts, true
 	return nil
 }
 
+// scanTimestampString parses a string returned by the database driver.
+// Besides RFC3339, it accepts the timestamp formats of the dialect selected with SetDialect,
+// and MySQL zero dates if enabled with SetMySQLZeroDates.
+func (t *Timestamp) scanTimestampString(s string) error {
+	if scanZeroDate(s) {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	err := t.parseTimestampString(s)
+	if err == nil {
+		return nil
+	}
+	for _, layout := range currentProfile().timestampLayouts {
+		if parsed, perr := time.ParseInLocation(layout, s, time.UTC); perr == nil {
+			return t.setChecked(parsed.UTC().Truncate(time.Second), s)
+		}
+	}
+	return err
+}
+
 // Value implements the driver.Valuer interface.
 // It converts the Timestamp into a database-compatible value (time.Time or NULL).
+// The SQLite dialect sends a "YYYY-MM-DD HH:MM:SS" UTC string instead of a time.Time,
+// or a number as selected with SetSQLiteStorage. SetTimestampValueKind overrides
+// the choice between string and time.Time.
 func (t Timestamp) Value() (driver.Value, error) {
 	if !t.Valid {
 		return nil, nil
 	}
-	return t.Time.UTC().Truncate(time.Second), nil
+	if inf, ok := infinityString(t.Time, t.Valid); ok {
+		return inf, nil
+	}
+	ts := t.Time.UTC().Truncate(time.Second)
+	if v, ok := sqliteValue(ts); ok {
+		return v, nil
+	}
+	return timestampValue(ts), nil
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // It converts the Timestamp into a JSON string in RFC3339 format, or null if invalid.
 func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(make([]byte, 0, jsonBufSize)), nil
+}
+
+// AppendJSON appends the JSON encoding of the Timestamp to b, as returned by MarshalJSON.
+func (t Timestamp) AppendJSON(b []byte) []byte {
 	if !t.Valid {
-		return []byte("null"), nil
+		return appendNullJSON(b, zeroTimestampJSON)
+	}
+	if inf, ok := infinityString(t.Time, t.Valid); ok {
+		return appendJSONString(b, inf)
 	}
-	return json.Marshal(t.Time.UTC().Truncate(time.Second).Format(timestampFormat))
+	if layout := outputLayout(&timestampLayout); layout != "" {
+		return appendJSONString(b, t.Time.UTC().Format(layout))
+	}
+	b = append(b, '"')
+	b = appendRFC3339UTC(b, t.Time.UTC().Truncate(time.Second))
+	return append(b, '"')
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // It parses a JSON string into a Timestamp, handling null and empty strings.
+// Fractional seconds, as emitted by protojson, are accepted and truncated.
+// A bare number is read as Unix seconds or milliseconds, see SetTimestampEpochUnit.
 func (t *Timestamp) UnmarshalJSON(data []byte) error {
 	str := string(data)
 	if str == "null" || str == `""` {
@@ -108,6 +210,22 @@ func (t *Timestamp) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
+	if isJSONNumber(data) {
+		parsed, err := parseEpochJSON(str)
+		if err != nil {
+			return err
+		}
+		return t.setChecked(parsed, str)
+	}
+
+	if strictJSON.Load() {
+		s, err := unquoteJSONStrict(data, "Timestamp")
+		if err != nil {
+			return err
+		}
+		return t.parseTimestampString(s)
+	}
+
 	// Remove surrounding quotes if present
 	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
 		str = str[1 : len(str)-1]
@@ -117,15 +235,112 @@ func (t *Timestamp) UnmarshalJSON(data []byte) error {
 }
 
 // IsZero reports whether the Timestamp is invalid or represents the zero time.
+// With the NullOnly policy set with SetZeroPolicy, only an invalid Timestamp is zero.
 func (t Timestamp) IsZero() bool {
-	return !t.Valid || t.Time.IsZero()
+	return isZero(t.Valid, t.Time.IsZero())
+}
+
+// IsNull reports whether the Timestamp is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (t Timestamp) IsNull() bool {
+	return !t.Valid
+}
+
+// HasValue reports whether the Timestamp is valid (non-NULL). It is the negation of IsNull.
+func (t Timestamp) HasValue() bool {
+	return t.Valid
+}
+
+// MustTime returns the time held by the Timestamp, panicking if it is invalid (NULL).
+// Use it where NULL has already been ruled out, e.g. in tests.
+func (t Timestamp) MustTime() time.Time {
+	if !t.Valid {
+		panic("types: MustTime called on a NULL Timestamp")
+	}
+	return t.Time
 }
 
-// String returns the Timestamp formatted in RFC3339, or an empty string if invalid.
+// String returns the Timestamp formatted in RFC3339, or in UTC in the layout set
+// with SetTimestampFormat, or an empty string if invalid.
+// An infinite Timestamp is returned as "infinity" or "-infinity".
 // Implements the fmt.Stringer interface.
 func (t Timestamp) String() string {
 	if !t.Valid {
 		return ""
 	}
+	if inf, ok := infinityString(t.Time, t.Valid); ok {
+		return inf
+	}
+	if layout := outputLayout(&timestampLayout); layout != "" {
+		return t.Time.UTC().Format(layout)
+	}
+	if canonicalOutput.Load() {
+		return string(appendRFC3339UTC(make([]byte, 0, jsonBufSize), t.Time.UTC().Truncate(time.Second)))
+	}
 	return t.Time.Format(timestampFormat)
 }
+
+// Set implements the flag.Value interface.
+// It parses an RFC3339 string into the Timestamp, marking it invalid if the string is empty.
+func (t *Timestamp) Set(s string) error {
+	return t.parseTimestampString(s)
+}
+
+// Type returns the type name shown in pflag usage output.
+func (t *Timestamp) Type() string {
+	return "timestamp"
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// An invalid Timestamp is encoded as empty text.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	return t.AppendText(nil)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// It appends the Timestamp as returned by String to b.
+func (t Timestamp) AppendText(b []byte) ([]byte, error) {
+	if !t.Valid {
+		return b, nil
+	}
+	if inf, ok := infinityString(t.Time, t.Valid); ok {
+		return append(b, inf...), nil
+	}
+	if layout := outputLayout(&timestampLayout); layout != "" {
+		return t.Time.UTC().AppendFormat(b, layout), nil
+	}
+	if t.Time.Location() == time.UTC && t.Time.Nanosecond() == 0 {
+		return appendRFC3339UTC(b, t.Time), nil
+	}
+	if canonicalOutput.Load() {
+		return appendRFC3339UTC(b, t.Time.UTC().Truncate(time.Second)), nil
+	}
+	return t.Time.AppendFormat(b, timestampFormat), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses an RFC3339 string into the Timestamp, marking it invalid if the text is empty.
+func (t *Timestamp) UnmarshalText(text []byte) error {
+	return t.parseTimestampString(string(text))
+}
+
+// UnmarshalParam implements echo's BindUnmarshaler and gin's BindUnmarshaler
+// interfaces, so path, query and form parameters bind directly into a Timestamp.
+func (t *Timestamp) UnmarshalParam(param string) error {
+	return t.parseTimestampString(param)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the Timestamp as an RFC3339 string, or nil if invalid.
+func (t Timestamp) LogValue() slog.Value {
+	if !t.Valid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(t.String())
+}
+
+// Format implements the fmt.Formatter interface.
+// See formatNullable for the supported verbs.
+func (t Timestamp) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, "Timestamp", "Time", t.String(), t.Valid)
+}
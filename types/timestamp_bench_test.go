@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+var benchTimestamp = types.NewTimestamp(time.Date(2024, 12, 25, 15, 4, 5, 0, time.UTC))
+
+func BenchmarkTimestampScan(b *testing.B) {
+	var ts types.Timestamp
+	benchScan(b, &ts, []byte("2024-12-25T15:04:05Z"))
+}
+
+func BenchmarkTimestampScanTime(b *testing.B) {
+	var ts types.Timestamp
+	benchScan(b, &ts, benchTimestamp.Time)
+}
+
+func BenchmarkTimestampValue(b *testing.B) {
+	benchValue(b, benchTimestamp)
+}
+
+func BenchmarkTimestampAppendJSON(b *testing.B) {
+	benchAppendJSON(b, benchTimestamp)
+}
+
+func BenchmarkTimestampMarshalJSON(b *testing.B) {
+	benchMarshalJSON(b, benchTimestamp)
+}
+
+func BenchmarkTimestampUnmarshalJSON(b *testing.B) {
+	var ts types.Timestamp
+	benchUnmarshalJSON(b, &ts, []byte(`"2024-12-25T15:04:05Z"`))
+}
+
+func TestTimestampAllocs(t *testing.T) {
+	var ts types.Timestamp
+	var bytesSrc, stringSrc, timeSrc any = []byte("2024-12-25T15:04:05Z"), "2024-12-25T15:04:05Z", benchTimestamp.Time
+	buf := make([]byte, 0, 64)
+	data := []byte(`"2024-12-25T15:04:05Z"`)
+
+	assertAllocs(t, "Scan([]byte)", 0, func() { _ = ts.Scan(bytesSrc) })
+	assertAllocs(t, "Scan(string)", 0, func() { _ = ts.Scan(stringSrc) })
+	assertAllocs(t, "Scan(time.Time)", 0, func() { _ = ts.Scan(timeSrc) })
+	assertAllocs(t, "AppendJSON", 0, func() { buf = benchTimestamp.AppendJSON(buf[:0]) })
+	assertAllocs(t, "MarshalJSON", 1, func() { bytesSink, _ = benchTimestamp.MarshalJSON() })
+	assertAllocs(t, "UnmarshalJSON", 0, func() { _ = ts.UnmarshalJSON(data) })
+	// Boxing the time.Time into a driver.Value.
+	assertAllocs(t, "Value", 1, func() { valueSink, _ = benchTimestamp.Value() })
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// TimestampSlice is a nullable slice of Timestamps. It is stored in PostgreSQL
+// as a timestamptz[] and encoded in JSON as an array of RFC3339 strings. NULL
+// elements are kept as invalid Timestamps.
+//
+// A NULL TimestampSlice differs from an empty one. Errors from parsing an element
+// report its index.
+type TimestampSlice struct {
+	Val   []Timestamp
+	Valid bool
+}
+
+// NewTimestampSlice returns a valid TimestampSlice holding timestamps.
+func NewTimestampSlice(timestamps ...Timestamp) TimestampSlice {
+	if timestamps == nil {
+		timestamps = []Timestamp{}
+	}
+	return TimestampSlice{Val: timestamps, Valid: true}
+}
+
+// NullTimestampSlice returns a NULL TimestampSlice. It is equivalent to TimestampSlice{}.
+func NullTimestampSlice() TimestampSlice {
+	return TimestampSlice{}
+}
+
+// Scan implements the sql.Scanner interface.
+// It parses a PostgreSQL timestamptz[] in text form, such as
+// {"2024-12-25 10:00:00+00",NULL}, handling NULL. Elements are parsed as by
+// Timestamp.Scan, and in PostgreSQL's text output regardless of the dialect.
+func (s *TimestampSlice) Scan(value any) error {
+	value = normalizeScanValue(value)
+	switch v := value.(type) {
+	case nil:
+		*s = TimestampSlice{}
+		return nil
+	case []byte:
+		return s.parseArray(string(v))
+	case string:
+		return s.parseArray(v)
+	default:
+		return unsupportedScanType("TimestampSlice", value)
+	}
+}
+
+// parseArray parses a one-dimensional PostgreSQL array literal of timestamps.
+func (s *TimestampSlice) parseArray(text string) error {
+	timestamps := []Timestamp{}
+	err := scanArrayLiteral(text, func(_ int, elem string, null bool) error {
+		var ts Timestamp
+		if !null {
+			if err := ts.scanArrayElem(elem); err != nil {
+				return err
+			}
+		}
+		timestamps = append(timestamps, ts)
+		return nil
+	})
+	if err != nil {
+		return invalidFormat("TimestampSlice", text, "timestamptz[] literal", err)
+	}
+	*s = TimestampSlice{Val: timestamps, Valid: true}
+	return nil
+}
+
+// scanArrayElem parses an element of a timestamptz[] literal into ts. Besides
+// what Timestamp.Scan accepts, PostgreSQL's text output, such as
+// "2024-12-25 10:00:00+00", is accepted regardless of the dialect.
+func (ts *Timestamp) scanArrayElem(s string) error {
+	err := ts.scanTimestampString(s)
+	if err == nil {
+		return nil
+	}
+	for _, layout := range dialectProfiles[Postgres].timestampLayouts {
+		if parsed, perr := time.ParseInLocation(layout, s, time.UTC); perr == nil {
+			return ts.setChecked(parsed.UTC().Truncate(time.Second), s)
+		}
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the slice as a PostgreSQL timestamptz[] literal, or nil if NULL.
+func (s TimestampSlice) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.String(), nil
+}
+
+// String returns the slice as a PostgreSQL array literal, e.g. {2024-12-25T10:00:00Z,NULL},
+// or an empty string if NULL.
+func (s TimestampSlice) String() string {
+	if !s.Valid {
+		return ""
+	}
+	b := make([]byte, 0, 2+len(s.Val)*21)
+	b = append(b, '{')
+	for i, ts := range s.Val {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if !ts.Valid {
+			b = append(b, "NULL"...)
+			continue
+		}
+		b = appendArrayElem(b, ts.String())
+	}
+	return string(append(b, '}'))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes the slice as a JSON array of timestamps, or null if NULL.
+func (s TimestampSlice) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, 2+len(s.Val)*23)), nil
+}
+
+// AppendJSON appends the JSON encoding of the TimestampSlice to b, as returned by MarshalJSON.
+func (s TimestampSlice) AppendJSON(b []byte) []byte {
+	if !s.Valid {
+		return appendNullJSON(b, zeroArrayJSON)
+	}
+	return AppendJSONArray(b, s.Val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes a JSON array of timestamps, handling null. Elements are decoded as by
+// Timestamp.UnmarshalJSON.
+func (s *TimestampSlice) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*s = TimestampSlice{}
+		return nil
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return invalidFormat("TimestampSlice", string(data), "JSON array of timestamps", err)
+	}
+	timestamps := make([]Timestamp, len(elems))
+	for i, elem := range elems {
+		if err := timestamps[i].UnmarshalJSON(elem); err != nil {
+			return invalidFormat("TimestampSlice", string(data), "JSON array of timestamps", fmt.Errorf("element %d: %w", i, err))
+		}
+	}
+	*s = TimestampSlice{Val: timestamps, Valid: true}
+	return nil
+}
+
+// IsZero reports whether the TimestampSlice is NULL or empty.
+// With the NullOnly policy set with SetZeroPolicy, only a NULL TimestampSlice is zero.
+func (s TimestampSlice) IsZero() bool {
+	return isZero(s.Valid, len(s.Val) == 0)
+}
+
+// IsNull reports whether the TimestampSlice is invalid (NULL). Unlike IsZero, it is not
+// affected by SetZeroPolicy.
+func (s TimestampSlice) IsNull() bool {
+	return !s.Valid
+}
+
+// HasValue reports whether the TimestampSlice is valid (non-NULL). It is the negation of IsNull.
+func (s TimestampSlice) HasValue() bool {
+	return s.Valid
+}
+
+// Equal reports whether s and other hold equal timestamps in the same order, or are
+// both NULL. An empty slice is not equal to a NULL one.
+func (s TimestampSlice) Equal(other TimestampSlice) bool {
+	if !s.Valid || !other.Valid {
+		return s.Valid == other.Valid
+	}
+	return slices.EqualFunc(s.Val, other.Val, Timestamp.Equal)
+}
+
+// Validate returns the error of the first timestamp in the slice that does not
+// validate, wrapped with its index.
+func (s TimestampSlice) Validate() error {
+	for i, ts := range s.Val {
+		if err := ts.Validate(); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func TestTimestampSliceScanPostgresOutput(t *testing.T) {
+	want := []types.Timestamp{
+		types.NewTimestamp(time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC)),
+		{},
+		types.NewTimestamp(time.Date(2024, 12, 25, 8, 30, 0, 0, time.UTC)),
+		types.NewTimestamp(time.Date(2024, 12, 25, 10, 0, 1, 0, time.UTC)),
+	}
+	for _, d := range []types.Dialect{types.Generic, types.Postgres, types.MySQL, types.SQLite, types.SQLServer} {
+		t.Run(d.String(), func(t *testing.T) {
+			types.SetDialect(d)
+			defer types.SetDialect(types.Generic)
+
+			var s types.TimestampSlice
+			src := `{"2024-12-25 10:00:00+00",NULL,"2024-12-25 10:00:00+01:30","2024-12-25 10:00:01.5+00"}`
+			if err := s.Scan(src); err != nil {
+				t.Fatalf("Scan(%q): %v", src, err)
+			}
+			if !s.Equal(types.NewTimestampSlice(want...)) {
+				t.Errorf("Scan(%q) = %v, want %v", src, s.Val, want)
+			}
+
+			v, err := s.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var back types.TimestampSlice
+			if err := back.Scan(v); err != nil {
+				t.Fatalf("Scan(%q): %v", v, err)
+			}
+			if !back.Equal(s) {
+				t.Errorf("Scan(Value()) = %v, want %v", back.Val, s.Val)
+			}
+		})
+	}
+}
+
+func TestTimestampSliceScanReportsIndex(t *testing.T) {
+	var s types.TimestampSlice
+	err := s.Scan(`{"2024-12-25 10:00:00+00",bogus}`)
+	if err == nil {
+		t.Fatal("Scan succeeded, want error")
+	}
+	if want := "element 1"; !strings.Contains(err.Error(), want) {
+		t.Errorf("Scan error %q does not mention %q", err, want)
+	}
+}
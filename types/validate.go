@@ -0,0 +1,186 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+)
+
+// Validator is implemented by all types in this package. Validate reports
+// whether a value is well-formed, which values produced by parsing, Scan and
+// the constructors always are. It catches values assembled by hand, such as a
+// Date with a time of day, that would otherwise be silently altered or rejected
+// by the database. Invalid (NULL) values are well-formed.
+type Validator interface {
+	Validate() error
+}
+
+// tagValidator is implemented by types with constraints given as options of
+// the `types` tag, such as FilePath's abs and ext.
+type tagValidator interface {
+	validateTag(tag reflect.StructTag) error
+}
+
+// ErrNull is returned by ValidateStruct for a field tagged `types:"notnull"`
+// holding an invalid (NULL) value.
+var ErrNull = errors.New("must not be null")
+
+// ValidateStruct validates the fields of the struct, or pointer to struct, s,
+// such as a request decoded from JSON. Fields implementing Validator must
+// validate, fields tagged `types:"notnull"` must be valid (non-NULL), and
+// type-specific options, such as FilePath's, must be satisfied:
+//
+//	type CreateEvent struct {
+//		Title types.String `json:"title" types:"notnull"`
+//		Day   types.Date   `json:"day" types:"notnull;format=02.01.2006"`
+//		Note  types.String `json:"note"`
+//	}
+//
+// The tag applies to the fields of s and of structs embedded in it, not to
+// nested struct fields. Each failing field is reported by its `json` tag name,
+// falling back to the Go name, wrapping ErrNull or the error returned by
+// Validate. All failures are joined with errors.Join.
+func ValidateStruct(s any) error {
+	rv := reflect.Indirect(reflect.ValueOf(s))
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot validate %T, expected a struct", s)
+	}
+	var errs []error
+	for _, f := range fields.Of(rv.Type(), "json") {
+		field, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			// Promoted through a nil embedded pointer.
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		}
+		v, ok := field.Interface().(Validator)
+		if !ok {
+			continue
+		}
+		tag := rv.Type().FieldByIndex(f.Index).Tag
+		_, notNull := typesTagOption(tag, "notnull")
+		if n, ok := v.(interface{ IsNull() bool }); ok && notNull && n.IsNull() {
+			errs = append(errs, fmt.Errorf("field %s: %w", f.Name, ErrNull))
+			continue
+		}
+		err = v.Validate()
+		if tv, ok := v.(tagValidator); ok && err == nil {
+			err = tv.validateTag(tag)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", f.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// typesTagOption returns the value of the option key in the `types` struct tag,
+// whose options are separated by semicolons, e.g. "notnull;format=02.01.2006".
+func typesTagOption(tag reflect.StructTag, key string) (string, bool) {
+	for opt := range strings.SplitSeq(tag.Get("types"), ";") {
+		name, value, _ := strings.Cut(opt, "=")
+		if name == key {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Validate returns an *ErrInvalidFormat if the Date is valid but has a time of
+// day, or lies outside the bounds selected with SetBounds.
+func (d Date) Validate() error {
+	if !d.Valid {
+		return nil
+	}
+	if err := checkBounds("Date", d.Time, d.Time.String()); err != nil {
+		return err
+	}
+	if !d.Time.Truncate(24 * time.Hour).Equal(d.Time) {
+		return invalidFormat("Date", d.Time.String(), "YYYY-MM-DD", errors.New("has a time of day"))
+	}
+	return nil
+}
+
+// Validate returns an *ErrInvalidFormat if the Time is valid but has seconds,
+// or a date other than January 1st of year 1.
+func (t Time) Validate() error {
+	if !t.Valid {
+		return nil
+	}
+	if y, m, d := t.Time.Date(); y != 1 || m != time.January || d != 1 {
+		return invalidFormat("Time", t.Time.String(), "HH:MM", errors.New("has a date"))
+	}
+	if t.Time.Second() != 0 || t.Time.Nanosecond() != 0 {
+		return invalidFormat("Time", t.Time.String(), "HH:MM", errInvalidSeconds)
+	}
+	return nil
+}
+
+// Validate returns an *ErrInvalidFormat if the Timestamp is valid but lies
+// outside the bounds selected with SetBounds.
+func (t Timestamp) Validate() error {
+	if !t.Valid {
+		return nil
+	}
+	if err := checkBounds("Timestamp", t.Time, t.Time.String()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate returns an *ErrInvalidFormat if the String is valid but is not
+// valid UTF-8 or contains a NUL byte, neither of which PostgreSQL's text type accepts.
+func (s String) Validate() error {
+	if !s.Valid {
+		return nil
+	}
+	if !utf8.ValidString(s.Val) {
+		return invalidFormat("String", s.Val, "UTF-8 text", errors.New("invalid UTF-8"))
+	}
+	if strings.IndexByte(s.Val, 0) >= 0 {
+		return invalidFormat("String", s.Val, "UTF-8 text", errors.New("contains a NUL byte"))
+	}
+	return nil
+}
+
+// Validate returns the error of the first date in the set that does not validate.
+func (s DateSet) Validate() error {
+	for _, d := range s.dates {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns an *ErrInvalidFormat if the RRule is valid but violates
+// RFC 5545, e.g. by setting both Count and Until.
+func (r RRule) Validate() error {
+	if !r.Valid {
+		return nil
+	}
+	if err := r.validate(); err != nil {
+		return invalidFormat("RRule", r.String(), "RFC 5545 RRULE", err)
+	}
+	return nil
+}
+
+// Validate returns nil: a CompactDate is well-formed by construction.
+func (c CompactDate) Validate() error {
+	return nil
+}
+
+// Validate returns nil: a CompactTime is well-formed by construction.
+func (c CompactTime) Validate() error {
+	return nil
+}
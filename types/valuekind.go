@@ -0,0 +1,84 @@
+package types
+
+import "time"
+
+// ValueKind selects the Go type that Value passes to the database driver.
+// Some drivers require time.Time parameters for date and time columns, while
+// others prefer text.
+type ValueKind int32
+
+const (
+	// DialectValue uses the default of the dialect selected with SetDialect:
+	// a string for Date and Time, and a time.Time for Timestamp unless the
+	// dialect formats timestamps as text.
+	DialectValue ValueKind = iota
+
+	// StringValue always passes a string, formatted for the dialect.
+	StringValue
+
+	// TimeValue always passes a time.Time in UTC. A Date is passed as midnight,
+	// and a Time as its time of day on January 1st of year 1.
+	TimeValue
+)
+
+// String returns the name of the value kind.
+func (k ValueKind) String() string {
+	switch k {
+	case DialectValue:
+		return "dialect"
+	case StringValue:
+		return "string"
+	case TimeValue:
+		return "time"
+	default:
+		return "unknown"
+	}
+}
+
+// dateValue converts a valid Date's time to the kind selected with SetDateValueKind.
+func dateValue(t time.Time) any {
+	if ValueKind(dateValueKind.Load()) == TimeValue {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	if layout := loadLayout(&dateLayout); layout != "" {
+		return t.Format(layout)
+	}
+	return t.Format(dateFormat)
+}
+
+// timeValue converts a valid Time's time to the kind selected with SetTimeValueKind.
+func timeValue(t time.Time) any {
+	if ValueKind(timeValueKind.Load()) == TimeValue {
+		h, m, _ := t.Clock()
+		return time.Date(1, 1, 1, h, m, 0, 0, time.UTC)
+	}
+	if layout := loadLayout(&timeLayout); layout != "" {
+		return t.Format(layout)
+	}
+	return t.Format(currentProfile().timeValueFormat)
+}
+
+// timestampValue converts a valid Timestamp's time, already normalized to UTC,
+// to the kind selected with SetTimestampValueKind. Strings use the layout set
+// with SetTimestampFormat, if any.
+func timestampValue(t time.Time) any {
+	layout := currentProfile().timestampValueFormat
+	custom := loadLayout(&timestampLayout)
+	switch ValueKind(timestampValueKind.Load()) {
+	case TimeValue:
+		return t
+	case StringValue:
+		if layout == "" {
+			layout = timestampFormat
+		}
+	default:
+		if layout == "" {
+			return t
+		}
+	}
+	if custom != "" {
+		layout = custom
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,48 @@
+package types
+
+import "time"
+
+// NextWeekday returns the first date after d that falls on wd. If d is itself
+// a wd, the date one week later is returned. An invalid or infinite d is
+// returned unchanged.
+func NextWeekday(d Date, wd time.Weekday) Date {
+	if !d.Valid || d.IsInfinite() {
+		return d
+	}
+	days := (int(wd)-int(d.Time.Weekday())+6)%7 + 1
+	return NewDateIn(d.Time.AddDate(0, 0, days), time.UTC)
+}
+
+// PreviousWeekday returns the last date before d that falls on wd. If d is
+// itself a wd, the date one week earlier is returned. An invalid or infinite
+// d is returned unchanged.
+func PreviousWeekday(d Date, wd time.Weekday) Date {
+	if !d.Valid || d.IsInfinite() {
+		return d
+	}
+	days := (int(d.Time.Weekday())-int(wd)+6)%7 + 1
+	return NewDateIn(d.Time.AddDate(0, 0, -days), time.UTC)
+}
+
+// NthWeekdayOfMonth returns the nth wd of the month, e.g. n = 1 for the first
+// Monday. Negative n counts from the end of the month, so -1 is the last wd.
+// If the month has no such day, as for n = 5 in most months or n = 0, the
+// returned Date is invalid.
+func NthWeekdayOfMonth(year int, month time.Month, wd time.Weekday, n int) Date {
+	if n == 0 || month < time.January || month > time.December {
+		return Date{}
+	}
+	last := daysIn(month, year)
+	var day int
+	if n > 0 {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		day = 1 + (int(wd)-int(first)+7)%7 + (n-1)*7
+	} else {
+		lastWd := time.Date(year, month, last, 0, 0, 0, 0, time.UTC).Weekday()
+		day = last - (int(lastWd)-int(wd)+7)%7 + (n+1)*7
+	}
+	if day < 1 || day > last {
+		return Date{}
+	}
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Valid: true}
+}
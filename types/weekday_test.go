@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func date(y int, m time.Month, d int) types.Date {
+	return types.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// TestDatesIgnoreNewDateLocation checks that functions deriving a Date from
+// another Date or from midnight UTC are not shifted by SetNewDateLocation.
+func TestDatesIgnoreNewDateLocation(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	types.SetNewDateLocation(ny)
+	defer types.SetNewDateLocation(nil)
+
+	monday := date(2024, time.July, 1)
+	tests := []struct {
+		name      string
+		got, want types.Date
+	}{
+		{"NextWeekday", types.NextWeekday(monday, time.Wednesday), date(2024, time.July, 3)},
+		{"NextWeekday same day", types.NextWeekday(monday, time.Monday), date(2024, time.July, 8)},
+		{"PreviousWeekday", types.PreviousWeekday(monday, time.Friday), date(2024, time.June, 28)},
+		{"PreviousWeekday same day", types.PreviousWeekday(monday, time.Monday), date(2024, time.June, 24)},
+		{"DateFromNullTime", types.DateFromNullTime(sql.NullTime{Time: monday.Time, Valid: true}), monday},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Zero literals written for invalid values with SetNullAsZeroJSON or ZeroJSON.
+const (
+	zeroDateJSON      = `"0001-01-01"`
+	zeroTimeJSON      = `"00:00"`
+	zeroTimestampJSON = `"0001-01-01T00:00:00Z"`
+	zeroStringJSON    = `""`
+	zeroArrayJSON     = `[]`
+	zeroMapJSON       = `{}`
+
+	zeroRangeJSON = `{"lower":0,"upper":0,"bounds":"[)"}`
+)
+
+// appendNullJSON appends the encoding of an invalid value to b: null, or zero
+// if enabled with SetNullAsZeroJSON.
+func appendNullJSON(b []byte, zero string) []byte {
+	if nullAsZero.Load() && !canonicalOutput.Load() {
+		return append(b, zero...)
+	}
+	return append(b, "null"...)
+}
+
+// ZeroJSON wraps a value so that it is encoded in JSON as the zero literal of
+// its type rather than null when invalid, regardless of SetNullAsZeroJSON.
+// It is meant for individual fields of payloads sent to consumers that cannot
+// handle nulls:
+//
+//	type Legacy struct {
+//		Name types.ZeroJSON[types.String] `json:"name"` // "" instead of null
+//		Due  types.ZeroJSON[types.Date]   `json:"due"`  // "0001-01-01" instead of null
+//	}
+//
+// Decoding and the database methods delegate to the wrapped value unchanged.
+type ZeroJSON[T Date | Time | Timestamp | String] struct {
+	Val T
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z ZeroJSON[T]) MarshalJSON() ([]byte, error) {
+	return z.AppendJSON(make([]byte, 0, jsonBufSize)), nil
+}
+
+// AppendJSON appends the JSON encoding of the wrapped value to b, as returned by MarshalJSON.
+func (z ZeroJSON[T]) AppendJSON(b []byte) []byte {
+	switch v := any(z.Val).(type) {
+	case Date:
+		if !v.Valid {
+			return append(b, zeroDateJSON...)
+		}
+		return v.AppendJSON(b)
+	case Time:
+		if !v.Valid {
+			return append(b, zeroTimeJSON...)
+		}
+		return v.AppendJSON(b)
+	case Timestamp:
+		if !v.Valid {
+			return append(b, zeroTimestampJSON...)
+		}
+		return v.AppendJSON(b)
+	case String:
+		if !v.Valid {
+			return append(b, zeroStringJSON...)
+		}
+		return v.AppendJSON(b)
+	default:
+		panic(fmt.Sprintf("types: unexpected ZeroJSON type %T", v))
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface by delegating to the wrapped value.
+func (z *ZeroJSON[T]) UnmarshalJSON(data []byte) error {
+	return any(&z.Val).(interface{ UnmarshalJSON([]byte) error }).UnmarshalJSON(data)
+}
+
+// Scan implements the sql.Scanner interface by delegating to the wrapped value.
+func (z *ZeroJSON[T]) Scan(value any) error {
+	return any(&z.Val).(interface{ Scan(any) error }).Scan(value)
+}
+
+// Value implements the driver.Valuer interface by delegating to the wrapped value.
+func (z ZeroJSON[T]) Value() (driver.Value, error) {
+	return any(z.Val).(driver.Valuer).Value()
+}
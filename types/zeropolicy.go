@@ -0,0 +1,37 @@
+package types
+
+// ZeroPolicy selects what IsZero reports, and so which values encoding/json's
+// omitzero option drops. It is selected package-wide with SetZeroPolicy.
+type ZeroPolicy int32
+
+const (
+	// NullOrZeroValue reports invalid values and valid values holding their
+	// type's zero value, such as the empty String, 00:00 or 0001-01-01, as zero.
+	// This is the default.
+	NullOrZeroValue ZeroPolicy = iota
+
+	// NullOnly reports only invalid values as zero, so omitzero still emits a
+	// valid empty String or midnight Time.
+	NullOnly
+)
+
+// String returns the name of the zero policy.
+func (p ZeroPolicy) String() string {
+	switch p {
+	case NullOrZeroValue:
+		return "null-or-zero-value"
+	case NullOnly:
+		return "null-only"
+	default:
+		return "unknown"
+	}
+}
+
+// isZero implements IsZero under the policy selected with SetZeroPolicy, for a
+// value that is valid and, if so, whether it holds its type's zero value.
+func isZero(valid, zeroValue bool) bool {
+	if !valid {
+		return true
+	}
+	return zeroValue && ZeroPolicy(zeroPolicy.Load()) == NullOrZeroValue
+}
@@ -0,0 +1,70 @@
+package types
+
+import "time"
+
+// Calendar arithmetic in a time zone. Adding 24*time.Hour to a Timestamp
+// moves the wall clock by an hour across a DST change; these helpers add
+// calendar days instead, so a reminder at 09:00 stays at 09:00 local time.
+
+// AddDaysIn returns the Timestamp n calendar days later in loc, keeping the
+// wall-clock time in loc. If that time does not exist on the target day
+// because of a DST gap, it is normalized the way time.Date does.
+// An invalid or infinite Timestamp is returned unchanged.
+func (t Timestamp) AddDaysIn(n int, loc *time.Location) Timestamp {
+	return t.AddDateIn(0, 0, n, loc)
+}
+
+// AddDateIn is like AddDaysIn, but adds years, months and days as
+// time.Time.AddDate does, in loc.
+func (t Timestamp) AddDateIn(years, months, days int, loc *time.Location) Timestamp {
+	if !t.Valid || t.IsInfinite() {
+		return t
+	}
+	return NewTimestamp(t.Time.In(loc).AddDate(years, months, days))
+}
+
+// StartOfDayIn returns the first instant of the Timestamp's day in loc.
+// On days where midnight is skipped by DST, this is the first instant that exists.
+// An invalid or infinite Timestamp is returned unchanged.
+func (t Timestamp) StartOfDayIn(loc *time.Location) Timestamp {
+	if !t.Valid || t.IsInfinite() {
+		return t
+	}
+	y, m, d := t.Time.In(loc).Date()
+	return startOfDay(y, m, d, loc)
+}
+
+// StartIn returns the first instant of the Date in loc, as a Timestamp.
+// An invalid Date yields an invalid Timestamp, and an infinite Date the
+// Timestamp of the same sign.
+func (d Date) StartIn(loc *time.Location) Timestamp {
+	if !d.Valid {
+		return Timestamp{}
+	}
+	if d.IsInfinite() {
+		return Timestamp{Time: d.Time, Valid: true}
+	}
+	y, m, day := d.Time.Date()
+	return startOfDay(y, m, day, loc)
+}
+
+// HoursInDay returns the length of the Date's day in loc, which is 23 or 25
+// hours on DST transition days. It returns 0 for an invalid or infinite Date.
+func (d Date) HoursInDay(loc *time.Location) time.Duration {
+	if !d.Valid || d.IsInfinite() {
+		return 0
+	}
+	y, m, day := d.Time.Date()
+	return startOfDay(y, m, day+1, loc).Time.Sub(startOfDay(y, m, day, loc).Time)
+}
+
+// startOfDay returns the first instant of the given day in loc.
+// time.Date does not guarantee which side of a DST gap a nonexistent midnight
+// is moved to, so a result on the previous day is moved forward to the hour.
+func startOfDay(y int, m time.Month, d int, loc *time.Location) Timestamp {
+	t := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	if t.Day() != time.Date(y, m, d, 12, 0, 0, 0, loc).Day() {
+		t = t.Add(time.Hour).Truncate(time.Hour)
+	}
+	return NewTimestamp(t)
+}
@@ -0,0 +1,134 @@
+// Package typesarrow converts slices of the types in package types to and from
+// Apache Arrow arrays, with invalid values recorded in the validity bitmap.
+// The resulting arrays can be written to Parquet with the pqarrow package.
+//
+// Arrays returned by this package must be released by the caller.
+package typesarrow
+
+import (
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// Arrow data types used for each type, for use in schema definitions.
+var (
+	DateType      arrow.DataType = arrow.FixedWidthTypes.Date32
+	TimeType      arrow.DataType = arrow.FixedWidthTypes.Time32ms
+	TimestampType arrow.DataType = &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}
+	StringType    arrow.DataType = arrow.BinaryTypes.String
+)
+
+// DateArray builds a Date32 array from values.
+func DateArray(mem memory.Allocator, values []types.Date) *array.Date32 {
+	b := array.NewDate32Builder(mem)
+	defer b.Release()
+
+	b.Reserve(len(values))
+	for _, v := range values {
+		if !v.Valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(arrow.Date32FromTime(v.Time))
+	}
+	return b.NewDate32Array()
+}
+
+// Dates converts a Date32 array into a slice of Dates.
+func Dates(arr *array.Date32) []types.Date {
+	values := make([]types.Date, arr.Len())
+	for i := range values {
+		if arr.IsValid(i) {
+			values[i] = types.NewDateIn(arr.Value(i).ToTime(), time.UTC)
+		}
+	}
+	return values
+}
+
+// TimeArray builds a millisecond Time32 array from values.
+func TimeArray(mem memory.Allocator, values []types.Time) *array.Time32 {
+	b := array.NewTime32Builder(mem, TimeType.(*arrow.Time32Type))
+	defer b.Release()
+
+	b.Reserve(len(values))
+	for _, v := range values {
+		if !v.Valid {
+			b.AppendNull()
+			continue
+		}
+		h, m, _ := v.Time.Clock()
+		b.Append(arrow.Time32((time.Duration(h)*time.Hour + time.Duration(m)*time.Minute).Milliseconds()))
+	}
+	return b.NewTime32Array()
+}
+
+// Times converts a Time32 array of any unit into a slice of Times.
+func Times(arr *array.Time32) []types.Time {
+	unit := arr.DataType().(*arrow.Time32Type).Unit
+	values := make([]types.Time, arr.Len())
+	for i := range values {
+		if arr.IsValid(i) {
+			values[i] = types.NewTime(arr.Value(i).ToTime(unit))
+		}
+	}
+	return values
+}
+
+// TimestampArray builds a millisecond UTC Timestamp array from values.
+func TimestampArray(mem memory.Allocator, values []types.Timestamp) *array.Timestamp {
+	b := array.NewTimestampBuilder(mem, TimestampType.(*arrow.TimestampType))
+	defer b.Release()
+
+	b.Reserve(len(values))
+	for _, v := range values {
+		if !v.Valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(arrow.Timestamp(v.Time.UnixMilli()))
+	}
+	return b.NewTimestampArray()
+}
+
+// Timestamps converts a Timestamp array of any unit and time zone into a slice of Timestamps.
+func Timestamps(arr *array.Timestamp) []types.Timestamp {
+	unit := arr.DataType().(*arrow.TimestampType).Unit
+	values := make([]types.Timestamp, arr.Len())
+	for i := range values {
+		if arr.IsValid(i) {
+			values[i] = types.NewTimestamp(arr.Value(i).ToTime(unit))
+		}
+	}
+	return values
+}
+
+// StringArray builds a String array from values.
+func StringArray(mem memory.Allocator, values []types.String) *array.String {
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	b.Reserve(len(values))
+	for _, v := range values {
+		if !v.Valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v.Val)
+	}
+	return b.NewStringArray()
+}
+
+// Strings converts a String array into a slice of Strings.
+func Strings(arr *array.String) []types.String {
+	values := make([]types.String, arr.Len())
+	for i := range values {
+		if arr.IsValid(i) {
+			values[i] = types.NewString(arr.Value(i))
+		}
+	}
+	return values
+}
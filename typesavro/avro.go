@@ -0,0 +1,188 @@
+// Package typesavro maps the types in package types to Avro logical types for
+// use with github.com/hamba/avro/v2:
+//
+//	Date       ["null", {"type": "int", "logicalType": "date"}]
+//	Time       ["null", {"type": "int", "logicalType": "time-millis"}]
+//	Timestamp  ["null", {"type": "long", "logicalType": "timestamp-millis"}]
+//	String     ["null", "string"]
+//
+// hamba/avro cannot encode these types as struct fields directly, so structs
+// are converted to and from generic records with ToRecord and FromRecord, or in
+// one step with Marshal and Unmarshal. Fields are named by the `avro` struct tag.
+package typesavro
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// Nullable union schemas for each type, for use in record field definitions.
+const (
+	DateSchema      = `["null",{"type":"int","logicalType":"date"}]`
+	TimeSchema      = `["null",{"type":"int","logicalType":"time-millis"}]`
+	TimestampSchema = `["null",{"type":"long","logicalType":"timestamp-millis"}]`
+	StringSchema    = `["null","string"]`
+)
+
+// Native converts v into the value hamba/avro expects for its logical type:
+// time.Time for Date and Timestamp, a time.Duration since midnight for Time and
+// string for String. Invalid values become nil. Any other value is returned unchanged.
+func Native(v any) any {
+	switch v := v.(type) {
+	case types.Date:
+		if !v.Valid {
+			return nil
+		}
+		return v.Time
+	case types.Time:
+		if !v.Valid {
+			return nil
+		}
+		h, m, _ := v.Time.Clock()
+		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
+	case types.Timestamp:
+		if !v.Valid {
+			return nil
+		}
+		return v.Time
+	case types.String:
+		if !v.Valid {
+			return nil
+		}
+		return v.Val
+	default:
+		return v
+	}
+}
+
+// Scan stores a value decoded by hamba/avro in dst, which must be a pointer to
+// one of the types in package types. A nil src marks dst invalid.
+func Scan(dst any, src any) error {
+	switch d := dst.(type) {
+	case *types.Date:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Date{}
+		case time.Time:
+			*d = types.NewDateIn(v, time.UTC)
+		default:
+			return fmt.Errorf("cannot scan Avro value %T into Date", src)
+		}
+	case *types.Time:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Time{}
+		case time.Duration:
+			*d = types.NewTime(time.Time{}.Add(v))
+		default:
+			return fmt.Errorf("cannot scan Avro value %T into Time", src)
+		}
+	case *types.Timestamp:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Timestamp{}
+		case time.Time:
+			*d = types.NewTimestamp(v)
+		default:
+			return fmt.Errorf("cannot scan Avro value %T into Timestamp", src)
+		}
+	case *types.String:
+		switch v := src.(type) {
+		case nil:
+			*d = types.String{}
+		case string:
+			*d = types.NewString(v)
+		default:
+			return fmt.Errorf("cannot scan Avro value %T into String", src)
+		}
+	default:
+		return fmt.Errorf("cannot scan Avro value into %T", dst)
+	}
+	return nil
+}
+
+// ToRecord converts a struct, or pointer to struct, into a generic Avro record.
+func ToRecord(v any) (map[string]any, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot convert %T to an Avro record, expected a struct", v)
+	}
+
+	rec := make(map[string]any, rv.NumField())
+	for _, f := range fields.Of(rv.Type(), "avro") {
+		field, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			continue
+		}
+		rec[f.Name] = Native(field.Interface())
+	}
+	return rec, nil
+}
+
+// FromRecord fills the struct pointed to by dest from a generic Avro record.
+// Record fields without a matching struct field are ignored.
+func FromRecord(rec map[string]any, dest any) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot convert an Avro record into %T, expected a pointer to a struct", dest)
+	}
+	rv = rv.Elem()
+
+	for _, f := range fields.Of(rv.Type(), "avro") {
+		src, ok := rec[f.Name]
+		if !ok {
+			continue
+		}
+		field, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		if err := setField(field, src); err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// Marshal encodes a struct containing types from package types with schema.
+func Marshal(schema avro.Schema, v any) ([]byte, error) {
+	rec, err := ToRecord(v)
+	if err != nil {
+		return nil, err
+	}
+	return avro.Marshal(schema, rec)
+}
+
+// Unmarshal decodes data with schema into the struct pointed to by dest.
+func Unmarshal(schema avro.Schema, data []byte, dest any) error {
+	var rec map[string]any
+	if err := avro.Unmarshal(schema, data, &rec); err != nil {
+		return err
+	}
+	return FromRecord(rec, dest)
+}
+
+// setField stores src in field, using Scan for types from package types and
+// plain assignment for everything else.
+func setField(field reflect.Value, src any) error {
+	switch ptr := field.Addr().Interface().(type) {
+	case *types.Date, *types.Time, *types.Timestamp, *types.String:
+		return Scan(ptr, src)
+	}
+
+	if src == nil {
+		field.SetZero()
+		return nil
+	}
+	sv := reflect.ValueOf(src)
+	if !sv.Type().AssignableTo(field.Type()) {
+		return fmt.Errorf("cannot store Avro value %T in %s", src, field.Type())
+	}
+	field.Set(sv)
+	return nil
+}
@@ -0,0 +1,189 @@
+// Package typesbq converts the types in package types to and from the values
+// used by the BigQuery client, so rows round-trip through DATE, TIME, DATETIME,
+// TIMESTAMP and STRING columns with NULL handling.
+//
+// The BigQuery client's reflection-based StructSaver and row loading do not
+// know about these types, so StructSaver and StructLoader are provided as
+// drop-in bigquery.ValueSaver and bigquery.ValueLoader implementations.
+package typesbq
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/j0h-dev/simple-types-go/typescivil"
+)
+
+// Value converts v into the value the BigQuery client expects for its column type:
+// civil.Date for Date, civil.Time for Time, time.Time for Timestamp and string for String.
+// Invalid values become nil. Any other value is returned unchanged.
+func Value(v any) bigquery.Value {
+	switch v := v.(type) {
+	case types.Date:
+		if !v.Valid {
+			return nil
+		}
+		return typescivil.FromDate(v)
+	case types.Time:
+		if !v.Valid {
+			return nil
+		}
+		return typescivil.FromTime(v)
+	case types.Timestamp:
+		if !v.Valid {
+			return nil
+		}
+		return v.Time
+	case types.String:
+		if !v.Valid {
+			return nil
+		}
+		return v.Val
+	default:
+		return v
+	}
+}
+
+// Scan stores the BigQuery value src in dst, which must be a pointer to one of
+// the types in package types. A nil src marks dst invalid.
+//
+// Timestamp accepts both TIMESTAMP (time.Time) and DATETIME (civil.DateTime)
+// values; the latter is interpreted as UTC.
+func Scan(dst any, src bigquery.Value) error {
+	switch d := dst.(type) {
+	case *types.Date:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Date{}
+		case civil.Date:
+			*d = typescivil.ToDate(v)
+		default:
+			return fmt.Errorf("cannot scan BigQuery value %T into Date", src)
+		}
+	case *types.Time:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Time{}
+		case civil.Time:
+			*d = typescivil.ToTime(v)
+		default:
+			return fmt.Errorf("cannot scan BigQuery value %T into Time", src)
+		}
+	case *types.Timestamp:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Timestamp{}
+		case time.Time:
+			*d = types.NewTimestamp(v)
+		case civil.DateTime:
+			*d = typescivil.ToTimestamp(v, time.UTC)
+		default:
+			return fmt.Errorf("cannot scan BigQuery value %T into Timestamp", src)
+		}
+	case *types.String:
+		switch v := src.(type) {
+		case nil:
+			*d = types.String{}
+		case string:
+			*d = types.NewString(v)
+		default:
+			return fmt.Errorf("cannot scan BigQuery value %T into String", src)
+		}
+	default:
+		return fmt.Errorf("cannot scan BigQuery value into %T", dst)
+	}
+	return nil
+}
+
+// StructSaver is a bigquery.ValueSaver for structs containing types from
+// package types. Columns are named by the `bigquery` struct tag, falling back
+// to the field name; fields tagged "-" and unexported fields are skipped.
+type StructSaver struct {
+	// Struct is the struct, or pointer to struct, to save.
+	Struct any
+
+	// InsertID is used by BigQuery for best-effort deduplication. It may be empty.
+	InsertID string
+}
+
+// Save implements the bigquery.ValueSaver interface.
+func (s *StructSaver) Save() (map[string]bigquery.Value, string, error) {
+	rv := reflect.Indirect(reflect.ValueOf(s.Struct))
+	if rv.Kind() != reflect.Struct {
+		return nil, "", fmt.Errorf("cannot save %T, expected a struct", s.Struct)
+	}
+
+	row := make(map[string]bigquery.Value, rv.NumField())
+	for _, f := range fields.Of(rv.Type(), "bigquery") {
+		// Fields promoted through a nil embedded pointer are left out of the row.
+		field, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			continue
+		}
+		row[f.Name] = Value(field.Interface())
+	}
+	return row, s.InsertID, nil
+}
+
+// StructLoader is a bigquery.ValueLoader that loads a row into a struct
+// containing types from package types. Columns are matched to fields the same
+// way as StructSaver, ignoring case; columns without a matching field are ignored.
+type StructLoader struct {
+	// Dest is a pointer to the struct to load into.
+	Dest any
+}
+
+// Load implements the bigquery.ValueLoader interface.
+func (l *StructLoader) Load(v []bigquery.Value, s bigquery.Schema) error {
+	rv := reflect.ValueOf(l.Dest)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot load into %T, expected a pointer to a struct", l.Dest)
+	}
+	rv = rv.Elem()
+
+	byName := make(map[string][]int)
+	for _, f := range fields.Of(rv.Type(), "bigquery") {
+		byName[strings.ToLower(f.Name)] = f.Index
+	}
+
+	for i, col := range s {
+		index, ok := byName[strings.ToLower(col.Name)]
+		if !ok || i >= len(v) {
+			continue
+		}
+		field, err := rv.FieldByIndexErr(index)
+		if err != nil {
+			return fmt.Errorf("column %s: %w", col.Name, err)
+		}
+		if err := loadField(field, v[i]); err != nil {
+			return fmt.Errorf("column %s: %w", col.Name, err)
+		}
+	}
+	return nil
+}
+
+// loadField stores src in field, using Scan for types from package types and
+// plain assignment for everything else.
+func loadField(field reflect.Value, src bigquery.Value) error {
+	switch ptr := field.Addr().Interface().(type) {
+	case *types.Date, *types.Time, *types.Timestamp, *types.String:
+		return Scan(ptr, src)
+	}
+
+	if src == nil {
+		field.SetZero()
+		return nil
+	}
+	sv := reflect.ValueOf(src)
+	if !sv.Type().AssignableTo(field.Type()) {
+		return fmt.Errorf("cannot load BigQuery value %T into %s", src, field.Type())
+	}
+	field.Set(sv)
+	return nil
+}
@@ -0,0 +1,64 @@
+// Package typescivil converts the types in package types to and from the
+// civil.Date, civil.Time and civil.DateTime types of cloud.google.com/go/civil,
+// as used by the BigQuery and Spanner clients. Invalid values convert to the
+// zero civil values.
+package typescivil
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// ToDate creates a Date from a civil.Date.
+// The Date is invalid if the civil.Date is not a valid calendar date.
+func ToDate(c civil.Date) types.Date {
+	if !c.IsValid() {
+		return types.Date{}
+	}
+	return types.Date{Time: c.In(time.UTC), Valid: true}
+}
+
+// FromDate returns the Date as a civil.Date, or the zero civil.Date if invalid.
+func FromDate(d types.Date) civil.Date {
+	if !d.Valid {
+		return civil.Date{}
+	}
+	return civil.DateOf(d.Time)
+}
+
+// ToTime creates a Time from a civil.Time, dropping seconds.
+// The Time is invalid if the civil.Time is not a valid time of day.
+func ToTime(c civil.Time) types.Time {
+	if !c.IsValid() {
+		return types.Time{}
+	}
+	return types.Time{Time: time.Date(1, 1, 1, c.Hour, c.Minute, 0, 0, time.UTC), Valid: true}
+}
+
+// FromTime returns the Time as a civil.Time, or the zero civil.Time if invalid.
+func FromTime(t types.Time) civil.Time {
+	if !t.Valid {
+		return civil.Time{}
+	}
+	return civil.TimeOf(t.Time)
+}
+
+// ToTimestamp creates a Timestamp from a civil.DateTime interpreted as
+// wall-clock time in loc. The Timestamp is invalid if the civil.DateTime is not valid.
+func ToTimestamp(dt civil.DateTime, loc *time.Location) types.Timestamp {
+	if !dt.IsValid() {
+		return types.Timestamp{}
+	}
+	return types.NewTimestamp(dt.In(loc))
+}
+
+// FromTimestamp returns the wall-clock date and time of the Timestamp in loc,
+// or the zero civil.DateTime if invalid.
+func FromTimestamp(t types.Timestamp, loc *time.Location) civil.DateTime {
+	if !t.Valid {
+		return civil.DateTime{}
+	}
+	return civil.DateTimeOf(t.Time.In(loc))
+}
@@ -0,0 +1,96 @@
+// Package typesconv converts between the types in package types, propagating
+// NULL: converting an invalid value always yields an invalid result.
+package typesconv
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// DateOf returns the calendar date of the Timestamp in loc.
+func DateOf(ts types.Timestamp, loc *time.Location) types.Date {
+	if !ts.Valid {
+		return types.Date{}
+	}
+	y, m, d := ts.Time.In(loc).Date()
+	return types.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+// TimeOf returns the time of day of the Timestamp in loc.
+func TimeOf(ts types.Timestamp, loc *time.Location) types.Time {
+	if !ts.Valid {
+		return types.Time{}
+	}
+	return types.NewTime(ts.Time.In(loc))
+}
+
+// TimestampOf combines a Date and a Time into the Timestamp of that wall
+// clock time in loc. The result is invalid if either input is invalid.
+//
+// Unlike types.CombineDateAndTime, it interprets the wall clock time in an
+// explicit location and propagates NULL.
+func TimestampOf(d types.Date, t types.Time, loc *time.Location) types.Timestamp {
+	if !d.Valid || !t.Valid {
+		return types.Timestamp{}
+	}
+	y, m, day := d.Time.Date()
+	h, mi, _ := t.Time.Clock()
+	return types.NewTimestamp(time.Date(y, m, day, h, mi, 0, 0, loc))
+}
+
+// ParseInt64 parses the String as a base-10 integer.
+func ParseInt64(s types.String) (sql.Null[int64], error) {
+	return parse(s, "int64", func(v string) (int64, error) {
+		return strconv.ParseInt(v, 10, 64)
+	})
+}
+
+// ParseFloat64 parses the String as a floating-point number.
+func ParseFloat64(s types.String) (sql.Null[float64], error) {
+	return parse(s, "float64", func(v string) (float64, error) {
+		return strconv.ParseFloat(v, 64)
+	})
+}
+
+// ParseBool parses the String as a boolean, accepting the values of strconv.ParseBool.
+func ParseBool(s types.String) (sql.Null[bool], error) {
+	return parse(s, "bool", strconv.ParseBool)
+}
+
+// parse converts a valid String with fn, returning NULL for an invalid one.
+func parse[T any](s types.String, kind string, fn func(string) (T, error)) (sql.Null[T], error) {
+	if !s.Valid {
+		return sql.Null[T]{}, nil
+	}
+	v, err := fn(s.Val)
+	if err != nil {
+		return sql.Null[T]{}, fmt.Errorf("cannot convert String %q to %s: %w", s.Val, kind, err)
+	}
+	return sql.Null[T]{V: v, Valid: true}, nil
+}
+
+// StringOf returns the text form of a Date, Time or Timestamp as a String,
+// as returned by its String method.
+func StringOf[T types.Date | types.Time | types.Timestamp](v T) types.String {
+	switch v := any(v).(type) {
+	case types.Date:
+		return stringOf(v.Valid, v.String())
+	case types.Time:
+		return stringOf(v.Valid, v.String())
+	case types.Timestamp:
+		return stringOf(v.Valid, v.String())
+	default:
+		return types.String{}
+	}
+}
+
+func stringOf(valid bool, s string) types.String {
+	if !valid {
+		return types.String{}
+	}
+	return types.NewString(s)
+}
@@ -0,0 +1,90 @@
+// Package typeseasyjson wraps the types in package types with implementations
+// of easyjson.Marshaler and easyjson.Unmarshaler, so easyjson-generated code for
+// structs containing them calls these directly instead of going through
+// MarshalJSON and UnmarshalJSON. The JSON produced and accepted is the same.
+//
+// Each wrapper embeds the corresponding type, so Scan, Value and the other
+// methods are those of package types.
+package typeseasyjson
+
+import (
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Date is a types.Date implementing the easyjson interfaces.
+type Date struct{ types.Date }
+
+// Time is a types.Time implementing the easyjson interfaces.
+type Time struct{ types.Time }
+
+// Timestamp is a types.Timestamp implementing the easyjson interfaces.
+type Timestamp struct{ types.Timestamp }
+
+// String is a types.String implementing the easyjson interfaces.
+type String struct{ types.String }
+
+// MarshalEasyJSON implements the easyjson.Marshaler interface.
+func (d Date) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Raw(d.AppendJSON(nil), nil)
+}
+
+// UnmarshalEasyJSON implements the easyjson.Unmarshaler interface.
+func (d *Date) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	data := l.Raw()
+	if !l.Ok() {
+		return
+	}
+	if err := d.UnmarshalJSON(data); err != nil {
+		l.AddError(err)
+	}
+}
+
+// MarshalEasyJSON implements the easyjson.Marshaler interface.
+func (t Time) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Raw(t.AppendJSON(nil), nil)
+}
+
+// UnmarshalEasyJSON implements the easyjson.Unmarshaler interface.
+func (t *Time) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	data := l.Raw()
+	if !l.Ok() {
+		return
+	}
+	if err := t.UnmarshalJSON(data); err != nil {
+		l.AddError(err)
+	}
+}
+
+// MarshalEasyJSON implements the easyjson.Marshaler interface.
+func (t Timestamp) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Raw(t.AppendJSON(nil), nil)
+}
+
+// UnmarshalEasyJSON implements the easyjson.Unmarshaler interface.
+func (t *Timestamp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	data := l.Raw()
+	if !l.Ok() {
+		return
+	}
+	if err := t.UnmarshalJSON(data); err != nil {
+		l.AddError(err)
+	}
+}
+
+// MarshalEasyJSON implements the easyjson.Marshaler interface.
+func (s String) MarshalEasyJSON(w *jwriter.Writer) {
+	w.Raw(s.AppendJSON(nil), nil)
+}
+
+// UnmarshalEasyJSON implements the easyjson.Unmarshaler interface.
+func (s *String) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	data := l.Raw()
+	if !l.Ok() {
+		return
+	}
+	if err := s.UnmarshalJSON(data); err != nil {
+		l.AddError(err)
+	}
+}
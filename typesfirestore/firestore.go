@@ -0,0 +1,182 @@
+// Package typesfirestore converts structs containing the types in package
+// types to and from the map form accepted by the Firestore client.
+//
+// Firestore's reflection-based encoder does not know about these types, so
+// documents should be written with the map returned by ToMap and read with
+// FromMap applied to DocumentSnapshot.Data:
+//
+//	data, err := typesfirestore.ToMap(user)
+//	_, err = doc.Set(ctx, data)
+//
+//	snap, err := doc.Get(ctx)
+//	err = typesfirestore.FromMap(snap.Data(), &user)
+//
+// Fields are named by the `firestore` struct tag, as with the Firestore client.
+// Only top-level fields are converted; nested structs are passed through unchanged.
+package typesfirestore
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// Value converts v into a value Firestore can store. Timestamp becomes a
+// time.Time, Date a YYYY-MM-DD string, Time an "HH:MM" string and String a
+// string. Invalid values become nil. Any other value is returned unchanged.
+func Value(v any) any {
+	switch v := v.(type) {
+	case types.Date:
+		if !v.Valid {
+			return nil
+		}
+		return v.String()
+	case types.Time:
+		if !v.Valid {
+			return nil
+		}
+		return v.String()
+	case types.String:
+		if !v.Valid {
+			return nil
+		}
+		return v.Val
+	case types.Timestamp:
+		if !v.Valid {
+			return nil
+		}
+		return v.Time
+	default:
+		return v
+	}
+}
+
+// Scan stores the Firestore value src in dst, which must be a pointer to one
+// of the types in package types. A nil src marks dst invalid.
+func Scan(dst any, src any) error {
+	switch d := dst.(type) {
+	case *types.Date:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Date{}
+		case string:
+			return d.Set(v)
+		case time.Time:
+			*d = types.NewDateIn(v, time.UTC)
+		default:
+			return fmt.Errorf("cannot scan Firestore value %T into Date", src)
+		}
+	case *types.Time:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Time{}
+		case string:
+			return d.Set(v)
+		default:
+			return fmt.Errorf("cannot scan Firestore value %T into Time", src)
+		}
+	case *types.Timestamp:
+		switch v := src.(type) {
+		case nil:
+			*d = types.Timestamp{}
+		case time.Time:
+			*d = types.NewTimestamp(v)
+		default:
+			return fmt.Errorf("cannot scan Firestore value %T into Timestamp", src)
+		}
+	case *types.String:
+		switch v := src.(type) {
+		case nil:
+			*d = types.String{}
+		case string:
+			*d = types.NewString(v)
+		default:
+			return fmt.Errorf("cannot scan Firestore value %T into String", src)
+		}
+	default:
+		return fmt.Errorf("cannot scan Firestore value into %T", dst)
+	}
+	return nil
+}
+
+// ToMap converts a struct, or pointer to struct, into a map suitable for
+// DocumentRef.Set. Fields tagged with omitempty are left out when invalid or zero.
+func ToMap(v any) (map[string]any, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot convert %T to a Firestore map, expected a struct", v)
+	}
+
+	data := make(map[string]any, rv.NumField())
+	for _, f := range fields.Of(rv.Type(), "firestore") {
+		field, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			continue
+		}
+		value := Value(field.Interface())
+		if f.OmitEmpty && (value == nil || field.IsZero()) {
+			continue
+		}
+		data[f.Name] = value
+	}
+	return data, nil
+}
+
+// FromMap fills the struct pointed to by dest from a Firestore document map.
+// Keys without a matching field are ignored, and fields without a matching key
+// are left unchanged.
+func FromMap(data map[string]any, dest any) error {
+	rv := reflect.ValueOf(dest)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot convert a Firestore map into %T, expected a pointer to a struct", dest)
+	}
+	rv = rv.Elem()
+
+	for _, f := range fields.Of(rv.Type(), "firestore") {
+		src, ok := data[f.Name]
+		if !ok {
+			continue
+		}
+		field, err := rv.FieldByIndexErr(f.Index)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		if err := setField(field, src); err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// setField stores src in field, using Scan for types from package types and
+// plain assignment or conversion for everything else.
+func setField(field reflect.Value, src any) error {
+	switch ptr := field.Addr().Interface().(type) {
+	case *types.Date, *types.Time, *types.Timestamp, *types.String:
+		return Scan(ptr, src)
+	}
+
+	if src == nil {
+		field.SetZero()
+		return nil
+	}
+	sv := reflect.ValueOf(src)
+	switch {
+	case sv.Type().AssignableTo(field.Type()):
+		field.Set(sv)
+	case isNumber(sv.Kind()) && isNumber(field.Kind()):
+		// Firestore returns every integer as int64 and every float as float64.
+		field.Set(sv.Convert(field.Type()))
+	default:
+		return fmt.Errorf("cannot store Firestore value %T in %s", src, field.Type())
+	}
+	return nil
+}
+
+// isNumber reports whether k is an integer or floating-point kind.
+func isNumber(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
@@ -0,0 +1,81 @@
+// Package typesform registers converters so URL query strings and HTML form
+// posts decode into the types in package types, using either
+// github.com/gorilla/schema or github.com/go-playground/form.
+//
+// Unlike their UnmarshalText methods, the converters treat an empty value as
+// NULL for every type, String included, since an empty form field usually means
+// "not provided".
+package typesform
+
+import (
+	"reflect"
+
+	"github.com/go-playground/form/v4"
+	"github.com/gorilla/schema"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// RegisterAll registers converters for every type in package types on a
+// gorilla/schema or go-playground/form decoder.
+func RegisterAll[D *schema.Decoder | *form.Decoder](decoder D) {
+	switch d := any(decoder).(type) {
+	case *schema.Decoder:
+		for _, c := range converters {
+			d.RegisterConverter(c.zero, schemaConverter(c.parse))
+		}
+	case *form.Decoder:
+		for _, c := range converters {
+			d.RegisterCustomTypeFunc(formConverter(c.parse), c.zero)
+		}
+	}
+}
+
+// converter parses a single form value into one of the types.
+type converter struct {
+	zero  any
+	parse func(string) (any, error)
+}
+
+var converters = []converter{
+	{types.Date{}, parseWith(func(d *types.Date, s string) error { return d.Set(s) })},
+	{types.Time{}, parseWith(func(t *types.Time, s string) error { return t.Set(s) })},
+	{types.Timestamp{}, parseWith(func(t *types.Timestamp, s string) error { return t.Set(s) })},
+	{types.String{}, parseWith(func(v *types.String, s string) error { return v.Set(s) })},
+}
+
+// parseWith adapts a setter into a parse function, mapping empty input to the zero (NULL) value.
+func parseWith[T any](set func(*T, string) error) func(string) (any, error) {
+	return func(s string) (any, error) {
+		var v T
+		if s == "" {
+			return v, nil
+		}
+		if err := set(&v, s); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// schemaConverter adapts parse to gorilla/schema, which reports a conversion
+// error when the returned reflect.Value is invalid.
+func schemaConverter(parse func(string) (any, error)) schema.Converter {
+	return func(s string) reflect.Value {
+		v, err := parse(s)
+		if err != nil {
+			return reflect.Value{}
+		}
+		return reflect.ValueOf(v)
+	}
+}
+
+// formConverter adapts parse to go-playground/form, using the first value when
+// a field is repeated.
+func formConverter(parse func(string) (any, error)) form.DecodeCustomTypeFunc {
+	return func(vals []string) (any, error) {
+		if len(vals) == 0 {
+			return parse("")
+		}
+		return parse(vals[0])
+	}
+}
@@ -0,0 +1,94 @@
+// Package typesgorm wraps the types in package types so that GORM's
+// AutoMigrate picks a column type suited to the connected dialect, such as
+// timestamptz for Timestamp on PostgreSQL, instead of the general data type
+// reported by their GormDataType methods.
+//
+// Each wrapper embeds the corresponding type, so Scan, Value, JSON and the other
+// methods are those of package types:
+//
+//	type Event struct {
+//		ID      int64
+//		Day     typesgorm.Date
+//		Created typesgorm.Timestamp
+//		Title   typesgorm.String `gorm:"size:64"`
+//	}
+package typesgorm
+
+import (
+	"fmt"
+
+	"github.com/j0h-dev/simple-types-go/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Date is a types.Date with dialect-specific GORM column types.
+type Date struct{ types.Date }
+
+// Time is a types.Time with dialect-specific GORM column types.
+type Time struct{ types.Time }
+
+// Timestamp is a types.Timestamp with dialect-specific GORM column types.
+type Timestamp struct{ types.Timestamp }
+
+// String is a types.String with dialect-specific GORM column types.
+type String struct{ types.String }
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface.
+// It returns the column type used by AutoMigrate for the connected dialect.
+func (Date) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres", "mysql", "sqlite", "sqlserver":
+		return "date"
+	}
+	return ""
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface.
+// It returns the column type used by AutoMigrate for the connected dialect.
+func (Time) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres", "mysql", "sqlserver":
+		return "time"
+	case "sqlite":
+		return "text"
+	}
+	return ""
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface.
+// It returns a timezone-aware column type where the dialect supports one.
+func (Timestamp) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return "timestamptz"
+	case "mysql", "sqlite":
+		return "datetime"
+	case "sqlserver":
+		return "datetimeoffset"
+	}
+	return ""
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface.
+// A size tag (e.g. `gorm:"size:64"`) selects a bounded varchar where the dialect needs one.
+func (String) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "postgres", "sqlite":
+		if field.Size > 0 {
+			return fmt.Sprintf("varchar(%d)", field.Size)
+		}
+		return "text"
+	case "mysql":
+		if field.Size > 0 {
+			return fmt.Sprintf("varchar(%d)", field.Size)
+		}
+		return "longtext"
+	case "sqlserver":
+		if field.Size > 0 && field.Size <= 4000 {
+			return fmt.Sprintf("nvarchar(%d)", field.Size)
+		}
+		return "nvarchar(MAX)"
+	}
+	return ""
+}
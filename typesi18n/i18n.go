@@ -0,0 +1,161 @@
+// Package typesi18n renders the types in package types and numbers for
+// display in a given language, using golang.org/x/text for language matching
+// and number formatting:
+//
+//	f := typesi18n.New(language.German)
+//	f.Date(d)             // "5. Juli 2024"
+//	f.Time(t)             // "14:30"
+//	f.Number(1234567.891) // "1.234.567,891"
+//
+// golang.org/x/text does not yet expose CLDR calendar data, so month names and
+// date and time patterns come from a small built-in table covering English,
+// German, French, Spanish, Italian, Dutch and Portuguese. Other languages fall
+// back to English. Invalid (NULL) values render as an empty string.
+package typesi18n
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// locale holds the calendar conventions of one language.
+type locale struct {
+	months [12]string
+
+	// datePattern renders a date, with {d}, {month} and {yyyy} placeholders.
+	datePattern string
+
+	// hour12 selects a 12-hour clock with AM/PM.
+	hour12 bool
+}
+
+var (
+	english = locale{
+		months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		datePattern: "{month} {d}, {yyyy}",
+		hour12:      true,
+	}
+	britishEnglish = locale{
+		months:      english.months,
+		datePattern: "{d} {month} {yyyy}",
+	}
+	german = locale{
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		datePattern: "{d}. {month} {yyyy}",
+	}
+	french = locale{
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		datePattern: "{d} {month} {yyyy}",
+	}
+	spanish = locale{
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		datePattern: "{d} de {month} de {yyyy}",
+	}
+	italian = locale{
+		months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		datePattern: "{d} {month} {yyyy}",
+	}
+	dutch = locale{
+		months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		datePattern: "{d} {month} {yyyy}",
+	}
+	portuguese = locale{
+		months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		datePattern: "{d} de {month} de {yyyy}",
+	}
+)
+
+// supported lists the languages with built-in calendar data. The first entry
+// is the fallback.
+var supported = []struct {
+	tag    language.Tag
+	locale *locale
+}{
+	{language.AmericanEnglish, &english},
+	{language.English, &english},
+	{language.BritishEnglish, &britishEnglish},
+	{language.German, &german},
+	{language.French, &french},
+	{language.Spanish, &spanish},
+	{language.Italian, &italian},
+	{language.Dutch, &dutch},
+	{language.Portuguese, &portuguese},
+}
+
+var matcher = func() language.Matcher {
+	tags := make([]language.Tag, len(supported))
+	for i, s := range supported {
+		tags[i] = s.tag
+	}
+	return language.NewMatcher(tags)
+}()
+
+// Formatter renders values for one language. It is safe for concurrent use.
+type Formatter struct {
+	tag     language.Tag
+	locale  *locale
+	printer *message.Printer
+}
+
+// New returns a Formatter for the language tag.
+func New(tag language.Tag) *Formatter {
+	_, i, _ := matcher.Match(tag)
+	return &Formatter{
+		tag:     tag,
+		locale:  supported[i].locale,
+		printer: message.NewPrinter(tag),
+	}
+}
+
+// Date renders the Date in the language's long form, e.g. "July 5, 2024".
+func (f *Formatter) Date(d types.Date) string {
+	if !d.Valid {
+		return ""
+	}
+	return f.formatDate(d.Time)
+}
+
+// Time renders the Time on the language's clock, e.g. "2:30 PM" or "14:30".
+func (f *Formatter) Time(t types.Time) string {
+	if !t.Valid {
+		return ""
+	}
+	return f.formatClock(t.Time)
+}
+
+// Timestamp renders the Timestamp's date and time of day in loc.
+func (f *Formatter) Timestamp(ts types.Timestamp, loc *time.Location) string {
+	if !ts.Valid {
+		return ""
+	}
+	t := ts.Time.In(loc)
+	return f.formatDate(t) + " " + f.formatClock(t)
+}
+
+// Number renders a number with the language's digit grouping and decimal
+// separator. v may be any integer or floating-point type.
+func (f *Formatter) Number(v any, opts ...number.Option) string {
+	return f.printer.Sprint(number.Decimal(v, opts...))
+}
+
+func (f *Formatter) formatDate(t time.Time) string {
+	y, m, d := t.Date()
+	return strings.NewReplacer(
+		"{d}", strconv.Itoa(d),
+		"{month}", f.locale.months[m-1],
+		"{yyyy}", strconv.Itoa(y),
+	).Replace(f.locale.datePattern)
+}
+
+func (f *Formatter) formatClock(t time.Time) string {
+	if f.locale.hour12 {
+		return t.Format("3:04 PM")
+	}
+	return t.Format("15:04")
+}
@@ -0,0 +1,46 @@
+// Package typesjsoniter registers native json-iterator encoders and decoders for
+// the types in package types, so they no longer go through jsoniter's slower
+// json.Marshaler fallback. The JSON produced and accepted is the same as that of
+// MarshalJSON and UnmarshalJSON, including any package-wide options.
+package typesjsoniter
+
+import (
+	"encoding/json"
+	"unsafe"
+
+	"github.com/j0h-dev/simple-types-go/types"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Register installs the encoders and decoders globally, for every jsoniter API.
+func Register() {
+	register[types.Date]("types.Date")
+	register[types.Time]("types.Time")
+	register[types.Timestamp]("types.Timestamp")
+	register[types.String]("types.String")
+}
+
+// register installs the encoder and decoder for T under the type name used by
+// jsoniter's registry.
+func register[T any, P interface {
+	*T
+	AppendJSON(b []byte) []byte
+	json.Unmarshaler
+}](typeName string) {
+	jsoniter.RegisterTypeEncoderFunc(typeName, func(ptr unsafe.Pointer, stream *jsoniter.Stream) {
+		stream.SetBuffer(P(ptr).AppendJSON(stream.Buffer()))
+	}, never)
+
+	jsoniter.RegisterTypeDecoderFunc(typeName, func(ptr unsafe.Pointer, iter *jsoniter.Iterator) {
+		data := iter.SkipAndReturnBytes()
+		if iter.Error != nil {
+			return
+		}
+		if err := P(ptr).UnmarshalJSON(data); err != nil {
+			iter.ReportError("decode "+typeName, err.Error())
+		}
+	})
+}
+
+// never reports a value as empty. Like encoding/json, omitempty has no effect on these struct types.
+func never(unsafe.Pointer) bool { return false }
@@ -0,0 +1,74 @@
+package a
+
+import (
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+func unchecked(d types.Date, s *types.String) {
+	_ = d.Time // want `d.Time read without checking d.Valid`
+	_ = s.Val  // want `s.Val read without checking s.Valid`
+}
+
+func checkedValid(d types.Date) time.Time {
+	if !d.Valid {
+		return time.Time{}
+	}
+	return d.Time
+}
+
+func checkedMethods(a, b, c types.Date) {
+	if a.HasValue() {
+		_ = a.Time
+	}
+	if !b.IsNull() {
+		_ = b.Time
+	}
+	_ = c.ValueOr(time.Time{})
+	_ = c.Time
+}
+
+func checkedTooLate(d types.Date) {
+	_ = d.Time // want `d.Time read without checking d.Valid`
+	if d.Valid {
+		return
+	}
+}
+
+func otherValue(a, b types.Date) {
+	if a.Valid {
+		_ = b.Time // want `b.Time read without checking b.Valid`
+	}
+}
+
+func fieldPath(v struct{ D types.Date }) {
+	_ = v.D.Time // want `v.D.Time read without checking v.D.Valid`
+	if v.D.Valid {
+		_ = v.D.Time
+	}
+}
+
+func writes(d *types.Date) {
+	d.Time = time.Now()
+	d.Valid = true
+}
+
+func methodsAreNotReads(d types.Date) string {
+	return d.String()
+}
+
+func closures(d types.Date) func() time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return func() time.Time { return d.Time }
+}
+
+var topLevel = func(d types.Date) time.Time {
+	return d.Time // want `d.Time read without checking d.Valid`
+}
+
+func notNullable(f types.Flag) bool {
+	return f.Val
+}
@@ -0,0 +1,38 @@
+// Package types is a stub of the real package with the shapes typeslint checks.
+package types
+
+import "time"
+
+type Date struct {
+	Time  time.Time
+	Valid bool
+}
+
+func (d Date) HasValue() bool { return d.Valid }
+
+func (d Date) IsNull() bool { return !d.Valid }
+
+func (d Date) ValueOr(fallback time.Time) time.Time {
+	if d.Valid {
+		return d.Time
+	}
+	return fallback
+}
+
+func (d Date) String() string { return "" }
+
+type String struct {
+	Val   string
+	Valid bool
+}
+
+// Bits has unexported fields only, and no Valid field.
+type Bits struct {
+	n int
+}
+
+// Flag has a Valid field that is not a bool.
+type Flag struct {
+	Val   bool
+	Valid int
+}
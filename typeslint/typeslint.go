@@ -0,0 +1,150 @@
+// Package typeslint provides an analyzer that reports reads of the value
+// fields of the nullable types in package types, such as Time or Val, without
+// a preceding check of validity, the most common bug with nullable wrapper
+// types. Every struct of package types with a Valid bool field is checked.
+//
+// The check is deliberately simple: a read of a field of x other than Valid
+// is accepted if x.Valid is referenced, or x.HasValue, x.IsNull or x.ValueOr
+// called, earlier in the same function. Run it with go vet:
+//
+//	go install github.com/j0h-dev/simple-types-go/cmd/typeslint@latest
+//	go vet -vettool=$(which typeslint) ./...
+package typeslint
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// typesPath is the import path of the package whose types are checked.
+const typesPath = "github.com/j0h-dev/simple-types-go/types"
+
+// Analyzer reports unchecked reads of the value fields of nullable types.
+var Analyzer = &analysis.Analyzer{
+	Name:     "typeslint",
+	Doc:      "report reads of the value fields of nullable types, such as .Time or .Val, without a preceding .Valid check",
+	URL:      "https://pkg.go.dev/github.com/j0h-dev/simple-types-go/typeslint",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// checks are the selectors that count as checking validity: the Valid field
+// and the methods that report or depend on it.
+var checks = map[string]bool{
+	"Valid":    true,
+	"HasValue": true,
+	"IsNull":   true,
+	"ValueOr":  true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// The package itself reads its fields after checking Valid in ways the
+	// simple check does not follow.
+	if pass.Pkg.Path() == typesPath {
+		return nil, nil
+	}
+
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}
+	ins.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return false
+		}
+		switch fn := n.(type) {
+		case *ast.FuncDecl:
+			if fn.Body != nil {
+				checkBody(pass, fn.Body)
+			}
+		case *ast.FuncLit:
+			// Only function literals outside any function, such as in package
+			// level variables; the others are checked with their enclosing function.
+			checkBody(pass, fn.Body)
+		}
+		// Do not descend into nested function literals.
+		return false
+	})
+	return nil, nil
+}
+
+// checkBody reports unchecked reads in a function body, including the
+// function literals it contains, so a check in the enclosing function also
+// covers closures.
+func checkBody(pass *analysis.Pass, body *ast.BlockStmt) {
+	// Positions at which X was checked, keyed by the source of X.
+	checked := make(map[string][]ast.Node)
+	writes := make(map[*ast.SelectorExpr]bool)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if sel, ok := ast.Unparen(lhs).(*ast.SelectorExpr); ok {
+					writes[sel] = true
+				}
+			}
+		case *ast.SelectorExpr:
+			if checks[n.Sel.Name] && nullable(pass, n.X) {
+				key := types.ExprString(n.X)
+				checked[key] = append(checked[key], n)
+			}
+		}
+		return true
+	})
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok || writes[sel] {
+			return true
+		}
+		if sel.Sel.Name == "Valid" || !nullable(pass, sel.X) {
+			return true
+		}
+		if s, ok := pass.TypesInfo.Selections[sel]; !ok || s.Kind() != types.FieldVal {
+			return true
+		}
+		key := types.ExprString(sel.X)
+		for _, c := range checked[key] {
+			if c.Pos() < sel.Pos() {
+				return true
+			}
+		}
+		pass.Reportf(sel.Sel.Pos(), "%s.%s read without checking %s.Valid", key, sel.Sel.Name, key)
+		return true
+	})
+}
+
+// nullable reports whether the type of expr, or the type it points to, is a
+// struct of package types with a Valid bool field.
+func nullable(pass *analysis.Pass, expr ast.Expr) bool {
+	t := pass.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return false
+	}
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != typesPath {
+		return false
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+	for i := range st.NumFields() {
+		f := st.Field(i)
+		if f.Name() == "Valid" {
+			b, ok := f.Type().(*types.Basic)
+			return ok && b.Kind() == types.Bool
+		}
+	}
+	return false
+}
@@ -0,0 +1,12 @@
+package typeslint_test
+
+import (
+	"testing"
+
+	"github.com/j0h-dev/simple-types-go/typeslint"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), typeslint.Analyzer, "a")
+}
@@ -0,0 +1,275 @@
+// Package typesnatural parses natural-language date and time expressions into
+// the types in package types, relative to an injected clock and location.
+// It is meant for chat commands and search filters:
+//
+//	p := typesnatural.Parser{Location: berlin}
+//	p.Date("next monday")              // the coming Monday
+//	p.Timestamp("tomorrow at 9:30")    // 09:30 tomorrow in Berlin
+//	p.Timestamp("in 2 weeks")          // exactly 14 days from now
+//	p.Timestamp("2024-07-05 14:00 CET") // 13:00 UTC
+//
+// Supported expressions, case-insensitively:
+//
+//   - now, today, tomorrow, yesterday
+//   - a weekday, "next <weekday>" or "last <weekday>"; a bare weekday means
+//     the next one after today
+//   - "in <n> <unit>" and "<n> <unit> ago", with units minute, hour, day,
+//     week, month and year, singular or plural
+//   - YYYY-MM-DD
+//
+// Any of these may be followed by a time of day, optionally introduced by
+// "at": HH:MM in 24-hour form, or H[:MM]am/pm. Absolute dates may end with a
+// zone: an offset like +02:00, an IANA name like Europe/Paris, or one of the
+// abbreviations UTC, GMT, CET, CEST, EET, EEST, WET, WEST, EST, EDT, CST,
+// CDT, MST, MDT, PST and PDT.
+package typesnatural
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// Parser parses expressions relative to a clock and location.
+// The zero value uses time.Now and time.Local.
+type Parser struct {
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	// Location is the zone expressions are interpreted in. If nil, time.Local is used.
+	Location *time.Location
+}
+
+// Date parses s into a Date. A time of day in s is accepted and ignored.
+func (p *Parser) Date(s string) (types.Date, error) {
+	t, _, err := p.parse(s)
+	if err != nil {
+		return types.Date{}, err
+	}
+	y, m, d := t.Date()
+	return types.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}, nil
+}
+
+// Timestamp parses s into a Timestamp. Expressions naming a day without a time
+// of day, such as "tomorrow", yield midnight of that day.
+func (p *Parser) Timestamp(s string) (types.Timestamp, error) {
+	t, _, err := p.parse(s)
+	if err != nil {
+		return types.Timestamp{}, err
+	}
+	return types.NewTimestamp(t), nil
+}
+
+// zoneAbbreviations maps common abbreviations to their UTC offsets in seconds.
+var zoneAbbreviations = map[string]int{
+	"utc": 0, "gmt": 0,
+	"cet": 1 * 3600, "cest": 2 * 3600,
+	"eet": 2 * 3600, "eest": 3 * 3600,
+	"wet": 0, "west": 1 * 3600,
+	"est": -5 * 3600, "edt": -4 * 3600,
+	"cst": -6 * 3600, "cdt": -5 * 3600,
+	"mst": -7 * 3600, "mdt": -6 * 3600,
+	"pst": -8 * 3600, "pdt": -7 * 3600,
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday,
+	"wednesday": time.Wednesday, "thursday": time.Thursday, "friday": time.Friday,
+	"saturday": time.Saturday,
+}
+
+// parse returns the time s refers to, and whether s named an instant (such as
+// "now" or "in 2 hours") rather than a day.
+func (p *Parser) parse(s string) (time.Time, bool, error) {
+	loc := p.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	now := time.Now()
+	if p.Now != nil {
+		now = p.Now()
+	}
+	now = now.In(loc)
+
+	// Keywords are matched case-insensitively; the original words are kept for
+	// zone names, which are case-sensitive.
+	orig := strings.Fields(s)
+	words := strings.Fields(strings.ToLower(s))
+	if len(words) == 0 {
+		return time.Time{}, false, fmt.Errorf("empty date expression")
+	}
+
+	// The day, and how many words it took.
+	var day time.Time
+	var n int
+	instant := false
+	switch w := words[0]; {
+	case w == "now":
+		day, n, instant = now, 1, true
+	case w == "today":
+		day, n = midnight(now), 1
+	case w == "tomorrow":
+		day, n = midnight(now).AddDate(0, 0, 1), 1
+	case w == "yesterday":
+		day, n = midnight(now).AddDate(0, 0, -1), 1
+	case (w == "next" || w == "last") && len(words) > 1:
+		wd, ok := weekdays[words[1]]
+		if !ok {
+			return time.Time{}, false, fmt.Errorf("unknown weekday %q", words[1])
+		}
+		day, n = relativeWeekday(now, wd, w == "next"), 2
+	case w == "in" && len(words) >= 3:
+		t, err := shift(now, words[1], words[2], 1)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		day, n, instant = t, 3, true
+	case len(words) >= 3 && words[2] == "ago":
+		t, err := shift(now, words[0], words[1], -1)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		day, n, instant = t, 3, true
+	default:
+		if wd, ok := weekdays[w]; ok {
+			day, n = relativeWeekday(now, wd, true), 1
+			break
+		}
+		d, err := time.ParseInLocation("2006-01-02", w, loc)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unrecognized date expression %q", s)
+		}
+		return absolute(d, words[1:], orig[1:], loc, s)
+	}
+
+	rest := words[n:]
+	if len(rest) > 0 && rest[0] == "at" {
+		rest = rest[1:]
+	}
+	switch len(rest) {
+	case 0:
+		return day, instant, nil
+	case 1:
+		h, m, err := parseClock(rest[0])
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		y, mo, d := day.Date()
+		return time.Date(y, mo, d, h, m, 0, 0, loc), true, nil
+	default:
+		return time.Time{}, false, fmt.Errorf("unrecognized date expression %q", s)
+	}
+}
+
+// absolute completes an absolute date with an optional time of day and zone.
+// rest and orig are the remaining words, lowercased and as given.
+func absolute(d time.Time, rest, orig []string, loc *time.Location, s string) (time.Time, bool, error) {
+	if len(rest) > 0 && rest[0] == "at" {
+		rest, orig = rest[1:], orig[1:]
+	}
+	if len(rest) == 0 {
+		return d, false, nil
+	}
+	h, m, err := parseClock(rest[0])
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	switch len(rest) {
+	case 1:
+	case 2:
+		if loc, err = parseZone(orig[1]); err != nil {
+			return time.Time{}, false, err
+		}
+	default:
+		return time.Time{}, false, fmt.Errorf("unrecognized date expression %q", s)
+	}
+	y, mo, day := d.Date()
+	return time.Date(y, mo, day, h, m, 0, 0, loc), true, nil
+}
+
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// relativeWeekday returns the next (or last) wd strictly after (or before) now's day.
+func relativeWeekday(now time.Time, wd time.Weekday, next bool) time.Time {
+	today := midnight(now)
+	if next {
+		days := (int(wd)-int(today.Weekday())+6)%7 + 1
+		return today.AddDate(0, 0, days)
+	}
+	days := (int(today.Weekday())-int(wd)+6)%7 + 1
+	return today.AddDate(0, 0, -days)
+}
+
+// shift moves now by count units in direction sign.
+func shift(now time.Time, count, unit string, sign int) (time.Time, error) {
+	n, err := strconv.Atoi(count)
+	if err != nil || n < 0 {
+		return time.Time{}, fmt.Errorf("invalid count %q", count)
+	}
+	n *= sign
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		return now.Add(time.Duration(n) * time.Minute), nil
+	case "hour":
+		return now.Add(time.Duration(n) * time.Hour), nil
+	case "day":
+		return now.AddDate(0, 0, n), nil
+	case "week":
+		return now.AddDate(0, 0, 7*n), nil
+	case "month":
+		return now.AddDate(0, n, 0), nil
+	case "year":
+		return now.AddDate(n, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown unit %q", unit)
+	}
+}
+
+// parseClock parses HH:MM, or H[:MM] followed by am or pm.
+func parseClock(s string) (hour, minute int, err error) {
+	pm := strings.HasSuffix(s, "pm")
+	am := strings.HasSuffix(s, "am")
+	if am || pm {
+		s = s[:len(s)-2]
+		if !strings.Contains(s, ":") {
+			s += ":00"
+		}
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time of day %q", s)
+	}
+	hour, minute = t.Hour(), t.Minute()
+	if am || pm {
+		if hour < 1 || hour > 12 {
+			return 0, 0, fmt.Errorf("invalid 12-hour time %q", s)
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	}
+	return hour, minute, nil
+}
+
+// parseZone parses an offset, an IANA zone name or a known abbreviation.
+func parseZone(s string) (*time.Location, error) {
+	if off, ok := zoneAbbreviations[strings.ToLower(s)]; ok {
+		return time.FixedZone(strings.ToUpper(s), off), nil
+	}
+	if t, err := time.Parse("-07:00", s); err == nil {
+		_, off := t.Zone()
+		return time.FixedZone("", off), nil
+	}
+	loc, err := time.LoadLocation(s)
+	if err != nil || s == "" || s == "Local" {
+		return nil, fmt.Errorf("unknown zone %q", s)
+	}
+	return loc, nil
+}
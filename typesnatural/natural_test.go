@@ -0,0 +1,150 @@
+package typesnatural_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/j0h-dev/simple-types-go/typesnatural"
+)
+
+var (
+	cest = time.FixedZone("CEST", 2*3600)
+	// now is Wednesday, 3 July 2024, 15:04:05 in cest.
+	now    = time.Date(2024, time.July, 3, 15, 4, 5, 0, cest)
+	parser = typesnatural.Parser{Now: func() time.Time { return now }, Location: cest}
+)
+
+func TestTimestamp(t *testing.T) {
+	at := func(m time.Month, d, h, mi, sec int) time.Time {
+		return time.Date(2024, m, d, h, mi, sec, 0, cest)
+	}
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"now", now},
+		{"today", at(time.July, 3, 0, 0, 0)},
+		{"Tomorrow", at(time.July, 4, 0, 0, 0)},
+		{"yesterday at 9:30", at(time.July, 2, 9, 30, 0)},
+		{"today 23:59", at(time.July, 3, 23, 59, 0)},
+		{"next monday", at(time.July, 8, 0, 0, 0)},
+		{"last Monday", at(time.July, 1, 0, 0, 0)},
+		{"wednesday", at(time.July, 10, 0, 0, 0)},
+		{"next wednesday", at(time.July, 10, 0, 0, 0)},
+		{"last wednesday", at(time.June, 26, 0, 0, 0)},
+		{"friday 5pm", at(time.July, 5, 17, 0, 0)},
+		{"friday at 12am", at(time.July, 5, 0, 0, 0)},
+		{"friday at 12:15pm", at(time.July, 5, 12, 15, 0)},
+		{"in 1 minute", at(time.July, 3, 15, 5, 5)},
+		{"in 2 hours", at(time.July, 3, 17, 4, 5)},
+		{"3 days ago", at(time.June, 30, 15, 4, 5)},
+		{"in 2 weeks", at(time.July, 17, 15, 4, 5)},
+		{"1 month ago", at(time.June, 3, 15, 4, 5)},
+		{"in 1 year", time.Date(2025, time.July, 3, 15, 4, 5, 0, cest)},
+		{"in 0 days", now},
+		{"in 2 days at 9:00", at(time.July, 5, 9, 0, 0)},
+		{"2024-07-05", at(time.July, 5, 0, 0, 0)},
+		{"2024-07-05 14:00", at(time.July, 5, 14, 0, 0)},
+		{"2024-07-05 14:00 CET", time.Date(2024, time.July, 5, 13, 0, 0, 0, time.UTC)},
+		{"2024-07-05 14:00 pst", time.Date(2024, time.July, 5, 22, 0, 0, 0, time.UTC)},
+		{"2024-07-05 at 2:30pm +05:30", time.Date(2024, time.July, 5, 9, 0, 0, 0, time.UTC)},
+		{"  2024-07-05   14:00   UTC ", time.Date(2024, time.July, 5, 14, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parser.Timestamp(tt.in)
+			if err != nil {
+				t.Fatalf("Timestamp(%q): %v", tt.in, err)
+			}
+			if want := types.NewTimestamp(tt.want); !got.Equal(want) {
+				t.Errorf("Timestamp(%q) = %v, want %v", tt.in, got, want)
+			}
+		})
+	}
+}
+
+func TestTimestampIANAZone(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Paris"); err != nil {
+		t.Skip(err)
+	}
+	got, err := parser.Timestamp("2024-01-05 14:00 Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := types.NewTimestamp(time.Date(2024, time.January, 5, 13, 0, 0, 0, time.UTC)); !got.Equal(want) {
+		t.Errorf("Timestamp = %v, want %v", got, want)
+	}
+}
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"today", time.Date(2024, time.July, 3, 0, 0, 0, 0, time.UTC)},
+		{"tomorrow at 23:30", time.Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)},
+		// The day is taken in the parser's location, not in UTC.
+		{"in 9 hours", time.Date(2024, time.July, 4, 0, 0, 0, 0, time.UTC)},
+		{"next sunday", time.Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC)},
+		{"2024-02-29", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"2024-02-29 23:00 -05:00", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parser.Date(tt.in)
+			if err != nil {
+				t.Fatalf("Date(%q): %v", tt.in, err)
+			}
+			if want := (types.Date{Time: tt.want, Valid: true}); !got.Equal(want) {
+				t.Errorf("Date(%q) = %v, want %v", tt.in, got, want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"   ",
+		"someday",
+		"next",
+		"next funday",
+		"last week",
+		"in two days",
+		"in -1 days",
+		"in 2 fortnights",
+		"2 days",
+		"tomorrow at 25:00",
+		"tomorrow at 9.30",
+		"tomorrow 13pm",
+		"tomorrow 0am",
+		"tomorrow at 9:30 sharp",
+		"2024-02-30",
+		"2024-7-5",
+		"2024-07-05 14:00 Mars/Olympus",
+		"2024-07-05 14:00 Local",
+		"2024-07-05 14:00 UTC sharp",
+	} {
+		t.Run(in, func(t *testing.T) {
+			if got, err := parser.Timestamp(in); err == nil {
+				t.Errorf("Timestamp(%q) = %v, want error", in, got)
+			}
+			if got, err := parser.Date(in); err == nil {
+				t.Errorf("Date(%q) = %v, want error", in, got)
+			}
+		})
+	}
+}
+
+func TestZeroParser(t *testing.T) {
+	var p typesnatural.Parser
+	before := time.Now().Truncate(time.Second)
+	got, err := p.Timestamp("now")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Time.Before(before) || got.Time.After(time.Now()) {
+		t.Errorf("Timestamp(now) = %v, want the current time", got)
+	}
+}
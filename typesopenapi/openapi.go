@@ -0,0 +1,102 @@
+// Package typesopenapi describes the types in package types as OpenAPI schema
+// fragments, so generated API documentation shows them as the strings they are
+// serialized to rather than as opaque {Time, Valid} objects.
+package typesopenapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// Schema is a minimal OpenAPI 3.0 schema fragment.
+type Schema struct {
+	Type     string `json:"type"`
+	Format   string `json:"format,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+	Example  string `json:"example,omitempty"`
+	Nullable bool   `json:"nullable"`
+}
+
+// schemas maps every type in package types to the schema of its JSON form.
+var schemas = map[reflect.Type]Schema{
+	reflect.TypeFor[types.Date](): {
+		Type:     "string",
+		Format:   "date",
+		Example:  "2024-01-31",
+		Nullable: true,
+	},
+	reflect.TypeFor[types.Time](): {
+		Type:     "string",
+		Format:   "time",
+		Pattern:  `^([01][0-9]|2[0-3]):[0-5][0-9]$`,
+		Example:  "13:45",
+		Nullable: true,
+	},
+	reflect.TypeFor[types.Timestamp](): {
+		Type:     "string",
+		Format:   "date-time",
+		Example:  "2024-01-31T13:45:00Z",
+		Nullable: true,
+	},
+	reflect.TypeFor[types.String](): {
+		Type:     "string",
+		Nullable: true,
+	},
+}
+
+// SchemaFor returns the schema fragment for t, dereferencing pointers.
+// It reports false if t is not one of the types in package types.
+func SchemaFor(t reflect.Type) (Schema, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	s, ok := schemas[t]
+	return s, ok
+}
+
+// OpenAPI3 converts the Schema into a kin-openapi schema.
+func (s Schema) OpenAPI3() *openapi3.Schema {
+	schema := &openapi3.Schema{
+		Type:     &openapi3.Types{s.Type},
+		Format:   s.Format,
+		Pattern:  s.Pattern,
+		Nullable: s.Nullable,
+	}
+	if s.Example != "" {
+		schema.Example = s.Example
+	}
+	return schema
+}
+
+// Customizer is an openapi3gen.SchemaCustomizerFn that replaces the generated
+// object schema of any type from package types with its string schema.
+// Schemas of other types are left untouched.
+//
+//	gen := openapi3gen.NewGenerator(openapi3gen.SchemaCustomizer(typesopenapi.Customizer))
+func Customizer(name string, t reflect.Type, tag reflect.StructTag, schema *openapi3.Schema) error {
+	s, ok := SchemaFor(t)
+	if !ok {
+		return nil
+	}
+	*schema = *s.OpenAPI3()
+	return nil
+}
+
+// SwaggoOverrides returns the contents of a .swaggo file instructing swag to
+// document every type in package types as a plain string.
+//
+// swag overrides cannot carry a format, so fields that should be documented as
+// date or date-time additionally need a `format:"date"` struct tag.
+func SwaggoOverrides() string {
+	lines := make([]string, 0, len(schemas))
+	for t, s := range schemas {
+		lines = append(lines, fmt.Sprintf("replace %s.%s %s", t.PkgPath(), t.Name(), s.Type))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,79 @@
+package typespgx
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/jackc/pgx/v5"
+)
+
+// Value converts v into the pgtype value pgx encodes natively, so it can be
+// passed to pgx without registering the codecs: pgtype.Date for Date,
+// pgtype.Time for Time, pgtype.Timestamptz for Timestamp and pgtype.Text for
+// String. Any other value is returned unchanged.
+func Value(v any) any {
+	switch v := v.(type) {
+	case types.Date:
+		d, _ := (*dateWrapper)(&v).DateValue()
+		return d
+	case types.Time:
+		t, _ := (*timeWrapper)(&v).TimeValue()
+		return t
+	case types.Timestamp:
+		t, _ := (*timestampWrapper)(&v).TimestamptzValue()
+		return t
+	case types.String:
+		s, _ := (*stringWrapper)(&v).TextValue()
+		return s
+	default:
+		return v
+	}
+}
+
+// CopyFromStructs returns the column names and a pgx.CopyFromSource for rows,
+// a slice of structs or pointers to structs, ready to pass to pgx.Conn.CopyFrom:
+//
+//	columns, src, err := typespgx.CopyFromStructs(users)
+//	if err != nil {
+//		return err
+//	}
+//	_, err = conn.CopyFrom(ctx, pgx.Identifier{"users"}, columns, src)
+//
+// Columns are named by the `db` struct tag, falling back to the field name;
+// fields tagged "-" and unexported fields are skipped. Field values are
+// converted with Value.
+func CopyFromStructs[T any](rows []T) ([]string, pgx.CopyFromSource, error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("cannot copy from %T, expected a struct", *new(T))
+	}
+
+	fs := fields.Of(t, "db")
+	columns := make([]string, len(fs))
+	for i, f := range fs {
+		columns[i] = f.Name
+	}
+
+	src := pgx.CopyFromSlice(len(rows), func(i int) ([]any, error) {
+		rv := reflect.Indirect(reflect.ValueOf(rows[i]))
+		if !rv.IsValid() {
+			return nil, fmt.Errorf("row %d is nil", i)
+		}
+		values := make([]any, len(fs))
+		for j, f := range fs {
+			// Fields promoted through a nil embedded pointer are copied as NULL.
+			field, err := rv.FieldByIndexErr(f.Index)
+			if err != nil {
+				continue
+			}
+			values[j] = Value(field.Interface())
+		}
+		return values, nil
+	})
+	return columns, src, nil
+}
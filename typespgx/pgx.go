@@ -0,0 +1,99 @@
+// Package typespgx registers codecs with pgx v5 so that the types in package
+// types are encoded and decoded with PostgreSQL's binary protocol instead of
+// going through the database/sql Scanner and Valuer text conversions.
+//
+// Register is typically called from pgxpool's AfterConnect hook:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		typespgx.Register(conn)
+//		return nil
+//	}
+package typespgx
+
+import (
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Register installs the codecs on the connection's type map.
+func Register(conn *pgx.Conn) {
+	RegisterMap(conn.TypeMap())
+}
+
+// RegisterMap installs the codecs on m. Each built-in PostgreSQL type is
+// re-registered with a codec that understands the matching type from package
+// types and defers to the original codec for everything else.
+func RegisterMap(m *pgtype.Map) {
+	wrapCodec(m, "date", wrapDate)
+	wrapCodec(m, "time", wrapTime)
+	wrapCodec(m, "timestamptz", wrapTimestamp)
+	wrapCodec(m, "timestamp", wrapTimestamp)
+	wrapCodec(m, "text", wrapString)
+	wrapCodec(m, "varchar", wrapString)
+
+	// Used when the parameter OID is unknown, e.g. with the simple protocol.
+	m.RegisterDefaultPgType(types.Date{}, "date")
+	m.RegisterDefaultPgType(types.Time{}, "time")
+	m.RegisterDefaultPgType(types.Timestamp{}, "timestamptz")
+	m.RegisterDefaultPgType(types.String{}, "text")
+}
+
+// wrapCodec replaces the codec of the named type with one that converts T to
+// its pgtype-aware wrapper before delegating to the original codec.
+func wrapCodec[T any](m *pgtype.Map, name string, wrap func(*T) any) {
+	t, ok := m.TypeForName(name)
+	if !ok {
+		return
+	}
+	m.RegisterType(&pgtype.Type{
+		Name:  t.Name,
+		OID:   t.OID,
+		Codec: &codec[T]{Codec: t.Codec, wrap: wrap},
+	})
+}
+
+// codec is a pgtype.Codec that additionally handles T.
+type codec[T any] struct {
+	pgtype.Codec
+	wrap func(*T) any
+}
+
+func (c *codec[T]) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if v, ok := value.(T); ok {
+		if next := c.Codec.PlanEncode(m, oid, format, c.wrap(&v)); next != nil {
+			return &encodePlan[T]{next: next, wrap: c.wrap}
+		}
+		return nil
+	}
+	return c.Codec.PlanEncode(m, oid, format, value)
+}
+
+func (c *codec[T]) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if v, ok := target.(*T); ok {
+		if next := c.Codec.PlanScan(m, oid, format, c.wrap(v)); next != nil {
+			return &scanPlan[T]{next: next, wrap: c.wrap}
+		}
+		return nil
+	}
+	return c.Codec.PlanScan(m, oid, format, target)
+}
+
+type encodePlan[T any] struct {
+	next pgtype.EncodePlan
+	wrap func(*T) any
+}
+
+func (p *encodePlan[T]) Encode(value any, buf []byte) ([]byte, error) {
+	v := value.(T)
+	return p.next.Encode(p.wrap(&v), buf)
+}
+
+type scanPlan[T any] struct {
+	next pgtype.ScanPlan
+	wrap func(*T) any
+}
+
+func (p *scanPlan[T]) Scan(src []byte, target any) error {
+	return p.next.Scan(src, p.wrap(target.(*T)))
+}
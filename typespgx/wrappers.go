@@ -0,0 +1,129 @@
+package typespgx
+
+import (
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// dateWrapper implements pgtype.DateScanner and pgtype.DateValuer for types.Date.
+type dateWrapper types.Date
+
+func wrapDate(d *types.Date) any { return (*dateWrapper)(d) }
+
+func (d *dateWrapper) ScanDate(v pgtype.Date) error {
+	if !v.Valid {
+		d.Time, d.Valid = time.Time{}, false
+		return nil
+	}
+	switch v.InfinityModifier {
+	case pgtype.Infinity:
+		*d = dateWrapper(types.DateInfinity())
+	case pgtype.NegativeInfinity:
+		*d = dateWrapper(types.DateNegativeInfinity())
+	default:
+		d.Time = v.Time
+		d.Valid = true
+	}
+	return nil
+}
+
+func (d *dateWrapper) DateValue() (pgtype.Date, error) {
+	inf := infinityModifier(d.Time, types.Date(*d).IsInfinite())
+	return pgtype.Date{Time: d.Time, InfinityModifier: inf, Valid: d.Valid}, nil
+}
+
+// timeWrapper implements pgtype.TimeScanner and pgtype.TimeValuer for types.Time.
+type timeWrapper types.Time
+
+func wrapTime(t *types.Time) any { return (*timeWrapper)(t) }
+
+func (t *timeWrapper) ScanTime(v pgtype.Time) error {
+	if !v.Valid {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	*t = timeWrapper(types.NewTime(time.Time{}.Add(time.Duration(v.Microseconds) * time.Microsecond)))
+	return nil
+}
+
+func (t *timeWrapper) TimeValue() (pgtype.Time, error) {
+	if !t.Valid {
+		return pgtype.Time{}, nil
+	}
+	h, m, _ := t.Time.Clock()
+	return pgtype.Time{
+		Microseconds: (time.Duration(h)*time.Hour + time.Duration(m)*time.Minute).Microseconds(),
+		Valid:        true,
+	}, nil
+}
+
+// timestampWrapper implements the pgtype timestamptz and timestamp scanner and
+// valuer interfaces for types.Timestamp.
+type timestampWrapper types.Timestamp
+
+func wrapTimestamp(t *types.Timestamp) any { return (*timestampWrapper)(t) }
+
+func (t *timestampWrapper) ScanTimestamptz(v pgtype.Timestamptz) error {
+	return t.scan(v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (t *timestampWrapper) TimestamptzValue() (pgtype.Timestamptz, error) {
+	return pgtype.Timestamptz{Time: t.Time, InfinityModifier: t.infinityModifier(), Valid: t.Valid}, nil
+}
+
+func (t *timestampWrapper) ScanTimestamp(v pgtype.Timestamp) error {
+	return t.scan(v.Time, v.InfinityModifier, v.Valid)
+}
+
+func (t *timestampWrapper) TimestampValue() (pgtype.Timestamp, error) {
+	return pgtype.Timestamp{Time: t.Time.UTC(), InfinityModifier: t.infinityModifier(), Valid: t.Valid}, nil
+}
+
+func (t *timestampWrapper) scan(v time.Time, inf pgtype.InfinityModifier, valid bool) error {
+	if !valid {
+		t.Time, t.Valid = time.Time{}, false
+		return nil
+	}
+	switch inf {
+	case pgtype.Infinity:
+		*t = timestampWrapper(types.TimestampInfinity())
+	case pgtype.NegativeInfinity:
+		*t = timestampWrapper(types.TimestampNegativeInfinity())
+	default:
+		*t = timestampWrapper(types.NewTimestamp(v))
+	}
+	return nil
+}
+
+func (t *timestampWrapper) infinityModifier() pgtype.InfinityModifier {
+	return infinityModifier(t.Time, types.Timestamp(*t).IsInfinite())
+}
+
+// infinityModifier maps the infinity sentinels of types.Date and
+// types.Timestamp to a pgtype.InfinityModifier.
+func infinityModifier(t time.Time, infinite bool) pgtype.InfinityModifier {
+	switch {
+	case !infinite:
+		return pgtype.Finite
+	case t.Year() > 0:
+		return pgtype.Infinity
+	default:
+		return pgtype.NegativeInfinity
+	}
+}
+
+// stringWrapper implements pgtype.TextScanner and pgtype.TextValuer for types.String.
+type stringWrapper types.String
+
+func wrapString(s *types.String) any { return (*stringWrapper)(s) }
+
+func (s *stringWrapper) ScanText(v pgtype.Text) error {
+	s.Val, s.Valid = v.String, v.Valid
+	return nil
+}
+
+func (s *stringWrapper) TextValue() (pgtype.Text, error) {
+	return pgtype.Text{String: s.Val, Valid: s.Valid}, nil
+}
@@ -0,0 +1,135 @@
+// Package typesspanner wraps the types in package types with implementations
+// of spanner.Encoder and spanner.Decoder, so they can be used as fields of
+// structs read and written with the Cloud Spanner client.
+//
+// Each wrapper embeds the corresponding type, so JSON and the other methods are
+// those of package types. Values the wrappers cannot decode yield a
+// *types.ErrUnsupportedScanType.
+package typesspanner
+
+import (
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/j0h-dev/simple-types-go/types"
+	"github.com/j0h-dev/simple-types-go/typescivil"
+)
+
+// Date is a types.Date stored as a Spanner DATE.
+type Date struct{ types.Date }
+
+// Time is a types.Time stored as a Spanner STRING.
+type Time struct{ types.Time }
+
+// Timestamp is a types.Timestamp stored as a Spanner TIMESTAMP.
+type Timestamp struct{ types.Timestamp }
+
+// String is a types.String stored as a Spanner STRING.
+type String struct{ types.String }
+
+// EncodeSpanner implements the spanner.Encoder interface.
+// It encodes the Date as a Spanner DATE, or a typed NULL if invalid.
+func (d Date) EncodeSpanner() (any, error) {
+	if !d.Valid {
+		return (*civil.Date)(nil), nil
+	}
+	return typescivil.FromDate(d.Date), nil
+}
+
+// DecodeSpanner implements the spanner.Decoder interface.
+// Spanner passes DATE columns in their YYYY-MM-DD wire form, and NULL as a nil *string.
+func (d *Date) DecodeSpanner(input any) error {
+	switch v := input.(type) {
+	case *string:
+		if v == nil {
+			d.Time, d.Valid = time.Time{}, false
+			return nil
+		}
+		return d.UnmarshalParam(*v)
+	case string:
+		return d.UnmarshalParam(v)
+	default:
+		return &types.ErrUnsupportedScanType{Type: "Date", Src: input}
+	}
+}
+
+// EncodeSpanner implements the spanner.Encoder interface.
+// Spanner has no time-of-day type, so the Time is encoded as an "HH:MM" STRING.
+func (t Time) EncodeSpanner() (any, error) {
+	if !t.Valid {
+		return (*string)(nil), nil
+	}
+	return t.Time.Time.Format("15:04"), nil
+}
+
+// DecodeSpanner implements the spanner.Decoder interface.
+// It parses an "HH:MM" STRING column, treating a nil *string as NULL.
+func (t *Time) DecodeSpanner(input any) error {
+	switch v := input.(type) {
+	case *string:
+		if v == nil {
+			t.Time = types.Time{}
+			return nil
+		}
+		return t.UnmarshalParam(*v)
+	case string:
+		return t.UnmarshalParam(v)
+	default:
+		return &types.ErrUnsupportedScanType{Type: "Time", Src: input}
+	}
+}
+
+// EncodeSpanner implements the spanner.Encoder interface.
+// It encodes the Timestamp as a Spanner TIMESTAMP, or a typed NULL if invalid.
+// Like Value, it normalizes the time to UTC and whole seconds.
+func (t Timestamp) EncodeSpanner() (any, error) {
+	if !t.Valid {
+		return (*time.Time)(nil), nil
+	}
+	return t.Time.UTC().Truncate(time.Second), nil
+}
+
+// DecodeSpanner implements the spanner.Decoder interface.
+// Spanner passes TIMESTAMP columns in their RFC3339 wire form, and NULL as a nil *string.
+func (t *Timestamp) DecodeSpanner(input any) error {
+	switch v := input.(type) {
+	case *string:
+		if v == nil {
+			t.Time, t.Valid = time.Time{}, false
+			return nil
+		}
+		return t.UnmarshalParam(*v)
+	case string:
+		return t.UnmarshalParam(v)
+	default:
+		return &types.ErrUnsupportedScanType{Type: "Timestamp", Src: input}
+	}
+}
+
+// EncodeSpanner implements the spanner.Encoder interface.
+// It encodes the String as a Spanner STRING, or a typed NULL if invalid.
+func (s String) EncodeSpanner() (any, error) {
+	if !s.Valid {
+		return (*string)(nil), nil
+	}
+	return s.Val, nil
+}
+
+// DecodeSpanner implements the spanner.Decoder interface.
+// A nil *string marks the String invalid.
+func (s *String) DecodeSpanner(input any) error {
+	switch v := input.(type) {
+	case *string:
+		if v == nil {
+			s.Val, s.Valid = "", false
+			return nil
+		}
+		s.Val, s.Valid = *v, true
+		return nil
+	case string:
+		s.Val, s.Valid = v, true
+		return nil
+	default:
+		return &types.ErrUnsupportedScanType{Type: "String", Src: input}
+	}
+}
@@ -0,0 +1,116 @@
+package typestest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// SQLRoundTripQuery is the query AssertSQLRoundTrip uses to send a value to
+// the database and read it back. Change it to match the database's
+// placeholder syntax, e.g. "SELECT $1" for PostgreSQL.
+var SQLRoundTripQuery = "SELECT ?"
+
+// AssertEqual fails the test if got does not equal want. Two invalid values are
+// equal regardless of their contents. Valid values of package types are
+// compared at the precision they are stored with: Date by calendar date, Time
+// by hour and minute, and Timestamp by instant truncated to the second. Other
+// values are compared with reflect.DeepEqual.
+func AssertEqual[T any](t testing.TB, want, got T) {
+	t.Helper()
+	if !Equal(want, got) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+// Equal reports whether a and b are equal, as described for AssertEqual.
+func Equal(a, b any) bool {
+	switch a := a.(type) {
+	case types.Date:
+		b, ok := b.(types.Date)
+		return ok && a.Valid == b.Valid && (!a.Valid || sameDate(a.Time, b.Time))
+	case types.Time:
+		b, ok := b.(types.Time)
+		return ok && a.Valid == b.Valid && (!a.Valid || sameClock(a.Time, b.Time))
+	case types.Timestamp:
+		b, ok := b.(types.Timestamp)
+		return ok && a.Valid == b.Valid && (!a.Valid || a.Time.Truncate(time.Second).Equal(b.Time.Truncate(time.Second)))
+	case types.String:
+		b, ok := b.(types.String)
+		return ok && a.Valid == b.Valid && (!a.Valid || a.Val == b.Val)
+	case types.DateSet:
+		b, ok := b.(types.DateSet)
+		return ok && a.Equal(b)
+	case types.RRule:
+		b, ok := b.(types.RRule)
+		return ok && a.Equal(b)
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func sameClock(a, b time.Time) bool {
+	return a.Hour() == b.Hour() && a.Minute() == b.Minute()
+}
+
+// AssertJSONRoundTrip fails the test if v does not survive encoding to JSON
+// and decoding back, compared with AssertEqual.
+func AssertJSONRoundTrip[T any](t testing.TB, v T) {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal %+v: %v", v, err)
+	}
+	var got T
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+	AssertEqual(t, v, got)
+}
+
+// AssertValueRoundTrip fails the test if v does not survive its Value method
+// and being scanned back, compared with AssertEqual. It checks, without a
+// database, that Scan accepts whatever Value emits under the current settings
+// of package types, such as the dialect:
+//
+//	typestest.AssertValueRoundTrip(t, types.NewDate(day))
+//
+// For every type and dialect in package types, this is guaranteed to pass.
+func AssertValueRoundTrip[T driver.Valuer, P interface {
+	*T
+	sql.Scanner
+}](t testing.TB, v T) {
+	t.Helper()
+	value, err := v.Value()
+	if err != nil {
+		t.Fatalf("value %+v: %v", v, err)
+	}
+	var got T
+	if err := P(&got).Scan(value); err != nil {
+		t.Fatalf("scan %#v: %v", value, err)
+	}
+	AssertEqual(t, v, got)
+}
+
+// AssertSQLRoundTrip fails the test if v does not survive being sent to the
+// database as a query argument and scanned back, compared with AssertEqual.
+// The query is SQLRoundTripQuery.
+func AssertSQLRoundTrip[T any](t testing.TB, db *sql.DB, v T) {
+	t.Helper()
+	var got T
+	if err := db.QueryRow(SQLRoundTripQuery, v).Scan(&got); err != nil {
+		t.Fatalf("round trip %+v: %v", v, err)
+	}
+	AssertEqual(t, v, got)
+}
@@ -0,0 +1,81 @@
+package typestest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// RunConformance checks that values of every type in package types survive
+// being sent to the database and scanned back, with the dialect and other
+// settings currently selected in package types, using AssertSQLRoundTrip. It
+// covers NULL, boundary and infinite values and runs one subtest per type.
+// Run it against each driver and dialect combination in use:
+//
+//	func TestDriverConformance(t *testing.T) {
+//		types.SetDialect(types.Postgres)
+//		typestest.SQLRoundTripQuery = "SELECT $1"
+//		typestest.RunConformance(t, db)
+//	}
+//
+// The database is only used to echo query arguments, so no schema is needed.
+func RunConformance(t *testing.T, db *sql.DB) {
+	t.Run("Date", func(t *testing.T) { runConformance(t, db, conformanceDates()) })
+	t.Run("Time", func(t *testing.T) { runConformance(t, db, conformanceTimes()) })
+	t.Run("Timestamp", func(t *testing.T) { runConformance(t, db, conformanceTimestamps()) })
+	t.Run("String", func(t *testing.T) { runConformance(t, db, conformanceStrings()) })
+}
+
+// runConformance checks each of values both without and with the database.
+func runConformance[T driver.Valuer, P interface {
+	*T
+	sql.Scanner
+}](t *testing.T, db *sql.DB, values []T) {
+	t.Helper()
+	for _, v := range values {
+		AssertValueRoundTrip[T, P](t, v)
+		AssertSQLRoundTrip(t, db, v)
+	}
+}
+
+func conformanceDates() []types.Date {
+	return []types.Date{
+		{},
+		{Time: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), Valid: true},
+		{Time: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		{Time: time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC), Valid: true},
+		types.DateInfinity(),
+		types.DateNegativeInfinity(),
+	}
+}
+
+func conformanceTimes() []types.Time {
+	return []types.Time{
+		{},
+		types.NewTime(time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewTime(time.Date(1, time.January, 1, 23, 59, 0, 0, time.UTC)),
+	}
+}
+
+func conformanceTimestamps() []types.Timestamp {
+	return []types.Timestamp{
+		{},
+		types.NewTimestamp(time.Date(2024, time.February, 29, 12, 34, 56, 0, time.UTC)),
+		types.NewTimestamp(time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		types.NewTimestamp(time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)),
+		types.TimestampInfinity(),
+		types.TimestampNegativeInfinity(),
+	}
+}
+
+func conformanceStrings() []types.String {
+	return []types.String{
+		{},
+		types.NewString(""),
+		types.NewString("it's a \"quoted\" string"),
+		types.NewString("ünïcödé ✓ 🙂"),
+	}
+}
@@ -0,0 +1,80 @@
+// Package typestest helps testing code that uses the types in package types,
+// in particular with database/sql and github.com/DATA-DOG/go-sqlmock.
+//
+// The values produced by the types' Value methods depend on package settings
+// such as the dialect and carry whatever location and precision the original
+// time had. ValueConverter and Match normalize them, so expectations compare
+// what is stored rather than how it happens to be represented:
+//
+//	db, mock, err := sqlmock.New(sqlmock.ValueConverterOption(typestest.ValueConverter))
+//	mock.ExpectExec("INSERT INTO users").
+//		WithArgs(typestest.Match(types.NewTimestamp(created)), typestest.Match("alice"))
+//
+// AssertEqual, AssertJSONRoundTrip, AssertValueRoundTrip and AssertSQLRoundTrip
+// standardize tests of models built on these types, comparing values at the
+// precision they are stored with. RunConformance checks a driver end to end.
+package typestest
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"reflect"
+	"time"
+)
+
+// ValueConverter is a driver.ValueConverter that converts arguments like
+// driver.DefaultParameterConverter and then normalizes them with Normalize.
+var ValueConverter driver.ValueConverter = valueConverter{}
+
+type valueConverter struct{}
+
+func (valueConverter) ConvertValue(v any) (driver.Value, error) {
+	value, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return Normalize(value), nil
+}
+
+// Normalize returns v in a canonical form for comparison: a time.Time is
+// converted to UTC and truncated to the second, and a []byte to a string.
+// Any other value is returned unchanged.
+func Normalize(v driver.Value) driver.Value {
+	switch v := v.(type) {
+	case time.Time:
+		return v.UTC().Truncate(time.Second)
+	case []byte:
+		return string(bytes.Clone(v))
+	default:
+		return v
+	}
+}
+
+// Matcher matches a driver argument against an expected value.
+// It implements sqlmock's Argument interface.
+type Matcher struct {
+	want driver.Value
+	err  error
+}
+
+// Match returns a Matcher for the expected argument, which is converted the
+// same way database/sql converts arguments, so a types.Timestamp, a time.Time
+// and an equal value from the driver all match one another.
+func Match(expected any) Matcher {
+	want, err := ValueConverter.ConvertValue(expected)
+	return Matcher{want: want, err: err}
+}
+
+// Match reports whether the argument v equals the expected value after
+// normalization. It never matches if the expected value could not be converted.
+func (m Matcher) Match(v driver.Value) bool {
+	if m.err != nil {
+		return false
+	}
+	got := Normalize(v)
+	if want, ok := m.want.(time.Time); ok {
+		t, ok := got.(time.Time)
+		return ok && t.Equal(want)
+	}
+	return reflect.DeepEqual(got, m.want)
+}
@@ -0,0 +1,228 @@
+// Package typesvalid validates struct fields of the types in package types
+// against composable constraints, producing structured field errors suitable
+// for API responses such as HTTP 422:
+//
+//	v := typesvalid.New().
+//		Field("Name", typesvalid.NotNull(), typesvalid.MaxLen(100)).
+//		Field("Status", typesvalid.OneOf("active", "suspended")).
+//		Field("Birthday", typesvalid.MaxDate(time.Now()))
+//
+//	if err := v.Validate(&user); err != nil {
+//		var fe typesvalid.FieldErrors
+//		if errors.As(err, &fe) {
+//			w.WriteHeader(http.StatusUnprocessableEntity)
+//			json.NewEncoder(w).Encode(fe)
+//		}
+//	}
+//
+// Apart from NotNull, constraints accept invalid (NULL) values.
+package typesvalid
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/j0h-dev/simple-types-go/internal/fields"
+	"github.com/j0h-dev/simple-types-go/types"
+)
+
+// Violation describes why a value does not satisfy a constraint.
+type Violation struct {
+	Code    string
+	Message string
+}
+
+// Constraint checks a single value, returning nil if it is satisfied.
+type Constraint func(v any) *Violation
+
+// FieldError is a violated constraint on a struct field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// FieldErrors is the error returned by Validator.Validate, listing every
+// violated constraint in the order the fields were added.
+type FieldErrors []FieldError
+
+// Error implements the error interface.
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Validator applies constraints to the fields of a struct.
+// It is built once with Field and can be reused concurrently.
+type Validator struct {
+	rules []fieldRule
+}
+
+type fieldRule struct {
+	name        string
+	constraints []Constraint
+}
+
+// New returns an empty Validator.
+func New() *Validator {
+	return &Validator{}
+}
+
+// Field adds constraints for the struct field with the given Go name and
+// returns the Validator, so calls can be chained.
+func (v *Validator) Field(name string, constraints ...Constraint) *Validator {
+	v.rules = append(v.rules, fieldRule{name: name, constraints: constraints})
+	return v
+}
+
+// Validate checks the struct, or pointer to struct, s. It returns FieldErrors
+// if any constraint is violated. Fields are reported by their `json` tag name,
+// falling back to the Go name. Only the first violation of each field is reported.
+func (v *Validator) Validate(s any) error {
+	rv := reflect.Indirect(reflect.ValueOf(s))
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot validate %T, expected a struct", s)
+	}
+
+	jsonNames := make(map[string]string)
+	for _, f := range fields.Of(rv.Type(), "json") {
+		jsonNames[rv.Type().FieldByIndex(f.Index).Name] = f.Name
+	}
+
+	var errs FieldErrors
+	for _, r := range v.rules {
+		sf, ok := rv.Type().FieldByName(r.name)
+		if !ok {
+			return fmt.Errorf("%s has no field %s", rv.Type(), r.name)
+		}
+		name := r.name
+		if jn, ok := jsonNames[r.name]; ok {
+			name = jn
+		}
+		fv, err := rv.FieldByIndexErr(sf.Index)
+		if err != nil {
+			continue
+		}
+		value := fv.Interface()
+		for _, c := range r.constraints {
+			if vi := c(value); vi != nil {
+				errs = append(errs, FieldError{Field: name, Code: vi.Code, Message: vi.Message})
+				break
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// valid reports whether v is a valid value of one of the types in package types,
+// that is any value with an IsNull method. Any other value is considered valid.
+func valid(v any) bool {
+	if n, ok := v.(interface{ IsNull() bool }); ok {
+		return !n.IsNull()
+	}
+	return true
+}
+
+// unsupported is returned by constraints applied to a type they do not handle.
+func unsupported(v any) *Violation {
+	return &Violation{Code: "unsupported_type", Message: fmt.Sprintf("constraint does not apply to %T", v)}
+}
+
+// NotNull requires the value to be valid.
+func NotNull() Constraint {
+	return func(v any) *Violation {
+		if !valid(v) {
+			return &Violation{Code: "not_null", Message: "must not be null"}
+		}
+		return nil
+	}
+}
+
+// MinDate requires a Date or Timestamp to be on or after min.
+func MinDate(min time.Time) Constraint {
+	return dateBound(min, "min_date", "must not be before", time.Time.Before)
+}
+
+// MaxDate requires a Date or Timestamp to be on or before max.
+func MaxDate(max time.Time) Constraint {
+	return dateBound(max, "max_date", "must not be after", time.Time.After)
+}
+
+// dateBound builds MinDate and MaxDate. Dates are compared by calendar date,
+// taking the date of bound in its own location, and Timestamps by instant.
+func dateBound(bound time.Time, code, msg string, violates func(t, bound time.Time) bool) Constraint {
+	by, bm, bd := bound.Date()
+	boundDay := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return func(v any) *Violation {
+		switch v := v.(type) {
+		case types.Date:
+			y, m, d := v.Time.Date()
+			if v.Valid && violates(time.Date(y, m, d, 0, 0, 0, 0, time.UTC), boundDay) {
+				return &Violation{Code: code, Message: msg + " " + bound.Format("2006-01-02")}
+			}
+		case types.Timestamp:
+			if v.Valid && violates(v.Time, bound) {
+				return &Violation{Code: code, Message: msg + " " + bound.Format(time.RFC3339)}
+			}
+		default:
+			return unsupported(v)
+		}
+		return nil
+	}
+}
+
+// MinLen requires a String to have at least n characters.
+func MinLen(n int) Constraint {
+	return stringCheck("min_len", fmt.Sprintf("must be at least %d characters", n), func(s string) bool {
+		return utf8.RuneCountInString(s) >= n
+	})
+}
+
+// MaxLen requires a String to have at most n characters.
+func MaxLen(n int) Constraint {
+	return stringCheck("max_len", fmt.Sprintf("must be at most %d characters", n), func(s string) bool {
+		return utf8.RuneCountInString(s) <= n
+	})
+}
+
+// OneOf requires a String to be one of the given values.
+func OneOf(values ...string) Constraint {
+	return stringCheck("one_of", "must be one of "+strings.Join(values, ", "), func(s string) bool {
+		return slices.Contains(values, s)
+	})
+}
+
+// Matches requires a String to match the regular expression.
+func Matches(re *regexp.Regexp) Constraint {
+	return stringCheck("matches", "must match "+re.String(), re.MatchString)
+}
+
+// stringCheck builds a constraint on valid Strings.
+func stringCheck(code, msg string, ok func(string) bool) Constraint {
+	return func(v any) *Violation {
+		s, isString := v.(types.String)
+		if !isString {
+			return unsupported(v)
+		}
+		if s.Valid && !ok(s.Val) {
+			return &Violation{Code: code, Message: msg}
+		}
+		return nil
+	}
+}